@@ -0,0 +1,211 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// APIParser talks to the Discuz mobile API (api/mobile/index.php), whose JSON
+// responses don't break when the site templates change and carry pids, user
+// IDs and exact timestamps.
+type APIParser struct{}
+
+const apiPath = "api/mobile/index.php?version=4&module="
+
+var attachPattern = regexp.MustCompile("\\[attach\\](\\d+)\\[/attach\\]")
+
+type apiResponse struct {
+	Variables json.RawMessage
+	Message   *struct {
+		Messageval string
+		Messagestr string
+	}
+}
+
+type apiForumIndex struct {
+	Forumlist []struct {
+		Fid  string
+		Name string
+	}
+}
+
+type apiForumDisplay struct {
+	ForumThreadlist []struct {
+		Tid          string
+		Subject      string
+		Author       string
+		Authorid     string
+		Replies      string
+		Displayorder string
+	} `json:"forum_threadlist"`
+}
+
+type apiAttachment struct {
+	Aid        string
+	Filename   string
+	Attachment string
+	URL        string
+	Isimage    string
+	Aidencode  string
+}
+
+type apiViewThread struct {
+	Postlist []struct {
+		Pid         string
+		Author      string
+		Authorid    string
+		Message     string
+		Number      string
+		Dbdateline  string
+		Attachments map[string]apiAttachment
+	}
+}
+
+func (APIParser) ForumsPath() string {
+	return apiPath + "forumindex"
+}
+
+func (APIParser) ThreadsPath(forum Forum, page int) string {
+	return fmt.Sprintf(apiPath+"forumdisplay&fid=%d&page=%d", forum.ID, page)
+}
+
+func (APIParser) PostsPath(thread Thread, page int) string {
+	return fmt.Sprintf(apiPath+"viewthread&tid=%d&page=%d", thread.ID, page)
+}
+
+func (APIParser) ParseForums(r io.Reader) (forums []Forum, err error) {
+	index := apiForumIndex{}
+	if err = decodeAPIResponse(r, &index); err != nil {
+		return
+	}
+	for _, forum := range index.Forumlist {
+		forums = append(forums, Forum{Title: forum.Name, ID: atoi(forum.Fid)})
+	}
+	return
+}
+
+func (APIParser) ParseThreads(r io.Reader, forum Forum) (threads []Thread, err error) {
+	display := apiForumDisplay{}
+	if err = decodeAPIResponse(r, &display); err != nil {
+		return
+	}
+	for _, thread := range display.ForumThreadlist {
+		threads = append(threads, Thread{
+			Forum:    forum,
+			Title:    thread.Subject,
+			ID:       atoi(thread.Tid),
+			Reply:    atoi(thread.Replies),
+			Author:   thread.Author,
+			AuthorID: atoi(thread.Authorid),
+			Sticky:   atoi(thread.Displayorder) > 0,
+		})
+	}
+	return
+}
+
+func (APIParser) ParsePosts(r io.Reader, thread Thread, page int) (posts []*Post, err error) {
+	view := apiViewThread{}
+	if err = decodeAPIResponse(r, &view); err != nil {
+		return
+	}
+	for _, p := range view.Postlist {
+		nodes, err := html.ParseFragment(strings.NewReader(p.Message), &html.Node{
+			Type:     html.ElementNode,
+			Data:     "div",
+			DataAtom: atom.Div,
+		})
+		if err != nil {
+			return posts, err
+		}
+		blocks := attachAPIAttachments(parseContent(nodes), p.Attachments)
+		post := &Post{
+			ID:       atoi(p.Pid),
+			Floor:    atoi(p.Number),
+			Author:   p.Author,
+			AuthorID: atoi(p.Authorid),
+			PostTime: time.Unix(int64(atoi(p.Dbdateline)), 0).In(tzshanghai),
+			Content:  PlainText(blocks),
+			Blocks:   blocks,
+			Thread:   thread,
+		}
+		parseEditBanner(post)
+		posts = append(posts, post)
+	}
+	return posts, nil
+}
+
+// attachAPIAttachments replaces [attach] placeholders in the message with the
+// attachments they refer to. Attachments that aren't referenced are appended.
+func attachAPIAttachments(blocks []Block, attachments map[string]apiAttachment) (result []Block) {
+	used := map[string]bool{}
+	for _, block := range blocks {
+		match := attachPattern.FindStringSubmatch(plainText(block))
+		if block.Kind == ParagraphBlock && match != nil {
+			if attachment, found := attachments[match[1]]; found {
+				used[match[1]] = true
+				result = append(result, attachment.block())
+				continue
+			}
+		}
+		result = append(result, block)
+	}
+
+	aids := []string{}
+	for aid := range attachments {
+		if !used[aid] {
+			aids = append(aids, aid)
+		}
+	}
+	sort.Strings(aids)
+	for _, aid := range aids {
+		result = append(result, attachments[aid].block())
+	}
+	return
+}
+
+func (a apiAttachment) block() Block {
+	if a.Isimage == "1" {
+		return Block{
+			Kind:    ParagraphBlock,
+			Inlines: []Inline{{Kind: ImageInline, Text: a.Filename, URL: a.URL + a.Attachment}},
+		}
+	}
+	return Block{
+		Kind: AttachmentBlock,
+		Name: a.Filename,
+		URL:  "forum.php?mod=attachment&aid=" + a.Aidencode,
+	}
+}
+
+func decodeAPIResponse(r io.Reader, variables interface{}) error {
+	resp := apiResponse{}
+	if err := json.NewDecoder(r).Decode(&resp); err != nil {
+		return err
+	}
+	if resp.Message != nil && len(resp.Message.Messageval) > 0 {
+		cause := fmt.Errorf("API error %s: %s", resp.Message.Messageval, resp.Message.Messagestr)
+		// e.g. to_login//1, viewperm_login_nopermission//1 or viewperm_none_nopermission//1
+		switch {
+		case strings.Contains(resp.Message.Messageval, "login"):
+			return &Error{Kind: ErrNotLoggedIn, Err: cause}
+		case strings.Contains(resp.Message.Messageval, "nopermission"):
+			return &Error{Kind: ErrPermissionDenied, Err: cause}
+		}
+		return cause
+	}
+	return json.Unmarshal(resp.Variables, variables)
+}
+
+func atoi(s string) int {
+	result, _ := strconv.Atoi(s)
+	return result
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+// ArchiverParser parses the lightweight /archiver/ pages. They are cheap to
+// fetch but lack post IDs, user IDs, ratings and signatures.
+type ArchiverParser struct{}
+
+func (ArchiverParser) ForumsPath() string {
+	return "archiver/"
+}
+
+func (ArchiverParser) ThreadsPath(forum Forum, page int) string {
+	return fmt.Sprintf("archiver/fid-%d.html?page=%d", forum.ID, page)
+}
+
+func (ArchiverParser) PostsPath(thread Thread, page int) string {
+	return fmt.Sprintf("archiver/tid-%d.html?page=%d", thread.ID, page)
+}
+
+func (ArchiverParser) ParseForums(r io.Reader) (forums []Forum, err error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return
+	}
+
+	forumNodes := doc.Find("#content a")
+	for i := range forumNodes.Nodes {
+		node := forumNodes.Eq(i)
+
+		link, found := node.Attr("href")
+		if !found {
+			err = errors.New("Cannot find forum link")
+			return
+		}
+
+		forum := Forum{
+			Title: node.Text(),
+			ID:    findIntAndParse(link),
+		}
+		forums = append(forums, forum)
+	}
+	return
+}
+
+func (ArchiverParser) ParseThreads(r io.Reader, forum Forum) (threads []Thread, err error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return
+	}
+
+	nodes := doc.Find("ul[type] li")
+	for i := range nodes.Nodes {
+		node := nodes.Eq(i)
+		linkNode := node.Find("a")
+
+		link, exists := linkNode.Attr("href")
+		if !exists {
+			err = errors.New("Cannot find thread link")
+			return
+		}
+
+		thread := Thread{
+			Forum: forum,
+			Title: linkNode.Text(),
+			ID:    findIntAndParse(link),
+			Reply: findIntAndParse(node.Nodes[0].LastChild.Data),
+		}
+		threads = append(threads, thread)
+	}
+	return
+}
+
+// ParsePosts parses a page of posts of the archiver. The archiver shows neither
+// pids nor floors, so the posts have neither: their position on the page only
+// gives their floor until a post before them is deleted.
+func (ArchiverParser) ParsePosts(r io.Reader, thread Thread, page int) (posts []*Post, err error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return
+	}
+
+	authorNodes := doc.Find(".author")
+	timePattern := regexp.MustCompile("\\d+-\\d+-\\d+ \\d+:\\d+")
+	authorPattern := regexp.MustCompile("<strong>(.*)</strong>")
+	for i := range authorNodes.Nodes {
+		node := authorNodes.Eq(i)
+		html, _ := node.Html()
+
+		postTime, err := parseTime(timePattern.FindString(html))
+		if err != nil {
+			return posts, err
+		}
+		author := authorPattern.FindStringSubmatch(html)[1]
+
+		post := &Post{
+			Author:   author,
+			Content:  getPostContent(node),
+			Blocks:   parseContent(postNodes(node)),
+			PostTime: postTime,
+			Thread:   thread,
+		}
+		parseEditBanner(post)
+		posts = append(posts, post)
+	}
+	return posts, nil
+}
+
+// postNodes returns nodes between the author of a post and the next post.
+func postNodes(author *goquery.Selection) (nodes []*html.Node) {
+	next := author.NextAllFiltered(".author,.page").Eq(0)
+	node := author.Nodes[0].NextSibling
+	for ; node != nil && node != next.Nodes[0]; node = node.NextSibling {
+		nodes = append(nodes, node)
+	}
+	return
+}
+
+func getPostContent(author *goquery.Selection) (content string) {
+	buf := bytes.Buffer{}
+	for _, node := range postNodes(author) {
+		if node.Type == html.TextNode {
+			buf.WriteString(node.Data)
+		}
+	}
+	return cleanContent(buf.String())
+}
+
+func cleanContent(content string) string {
+	// Remove \t s at begin/end of a line
+	content = regexp.MustCompile("(?m:(^\\t+)|(\\t+$))").ReplaceAllString(content, "")
+	// Remove new lines at begin/end of a post.
+	content = regexp.MustCompile("(^[\\r\\n]+)|([\\r\\n]+)$").ReplaceAllString(content, "")
+	return content
+}
@@ -0,0 +1,286 @@
+package client
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// InlineKind is the type of an element inside a paragraph.
+type InlineKind int
+
+const (
+	TextInline InlineKind = iota
+	LinkInline
+	ImageInline
+	SmileyInline
+)
+
+// BlockKind is the type of a block of post content.
+type BlockKind int
+
+const (
+	ParagraphBlock BlockKind = iota
+	QuoteBlock
+	AttachmentBlock
+)
+
+// Inline is a piece of text, a link, an image or a smiley in a paragraph.
+type Inline struct {
+	Kind InlineKind
+	Text string
+	URL  string
+}
+
+// Block is a paragraph, a quote of another post or an attachment.
+type Block struct {
+	Kind BlockKind
+	// Inlines of a paragraph.
+	Inlines []Inline
+	// QuoteAuthor and Blocks of a quote.
+	QuoteAuthor string
+	Blocks      []Block
+	// URL and Name of an attachment.
+	URL  string
+	Name string
+}
+
+var quoteHeaderPattern = regexp.MustCompile("^(.+?) 发表于 \\d+-\\d+-\\d+ \\d+:\\d+$")
+
+// parseContent converts post HTML into blocks. Empty paragraphs are kept
+// until quotes are grouped, since the archiver separates a quote from the
+// reply with an empty line.
+func parseContent(nodes []*html.Node) []Block {
+	return removeEmptyParagraphs(groupQuotes(parseBlocks(nodes)))
+}
+
+func parseBlocks(nodes []*html.Node) []Block {
+	p := contentParser{}
+	for _, node := range nodes {
+		p.walk(node)
+	}
+	p.endParagraph()
+	return p.blocks
+}
+
+type contentParser struct {
+	blocks    []Block
+	paragraph []Inline
+}
+
+func (p *contentParser) walk(node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		p.addText(node.Data)
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	sel := goquery.NewDocumentFromNode(node).Selection
+	switch node.DataAtom {
+	case atom.Script, atom.Style, atom.H3:
+	case atom.Br:
+		p.endParagraph()
+	case atom.Img:
+		p.addImage(sel)
+	case atom.A:
+		if sel.Find("img").Length() > 0 {
+			p.walkChildren(node)
+			break
+		}
+		href, _ := sel.Attr("href")
+		p.paragraph = append(p.paragraph, Inline{Kind: LinkInline, Text: strings.TrimSpace(sel.Text()), URL: href})
+	case atom.Blockquote:
+		p.endParagraph()
+		p.blocks = append(p.blocks, parseQuote(node))
+	default:
+		if sel.Is("div.quote") {
+			p.endParagraph()
+			if quote := sel.Find("blockquote"); quote.Length() > 0 {
+				p.blocks = append(p.blocks, parseQuote(quote.Nodes[0]))
+			}
+			break
+		}
+		if sel.Is("ignore_js_op, dl.tattl") {
+			p.endParagraph()
+			p.addAttachment(sel)
+			break
+		}
+		if sel.Is("div.pstatus, i.pstatus") {
+			// "本帖最后由 ... 编辑" banner of the full page.
+			p.addText(sel.Text())
+			p.endParagraph()
+			break
+		}
+		block := node.DataAtom == atom.P || node.DataAtom == atom.Div
+		if block {
+			p.endParagraph()
+		}
+		p.walkChildren(node)
+		if block {
+			p.endParagraph()
+		}
+	}
+}
+
+func (p *contentParser) walkChildren(node *html.Node) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		p.walk(child)
+	}
+}
+
+func (p *contentParser) addText(text string) {
+	text = strings.NewReplacer("\t", "", "\r", "", "\n", "").Replace(text)
+	if len(text) == 0 {
+		return
+	}
+	if last := len(p.paragraph) - 1; last >= 0 && p.paragraph[last].Kind == TextInline {
+		p.paragraph[last].Text += text
+		return
+	}
+	p.paragraph = append(p.paragraph, Inline{Kind: TextInline, Text: text})
+}
+
+func (p *contentParser) addImage(img *goquery.Selection) {
+	src, _ := img.Attr("src")
+	if _, smiley := img.Attr("smilieid"); smiley || strings.Contains(src, "smiley") {
+		alt, _ := img.Attr("alt")
+		p.paragraph = append(p.paragraph, Inline{Kind: SmileyInline, Text: alt, URL: src})
+		return
+	}
+	// Attached images are lazy loaded from "zoomfile" or "file".
+	for _, attr := range []string{"zoomfile", "file"} {
+		if url, found := img.Attr(attr); found {
+			src = url
+			break
+		}
+	}
+	alt, _ := img.Attr("alt")
+	p.paragraph = append(p.paragraph, Inline{Kind: ImageInline, Text: alt, URL: src})
+}
+
+func (p *contentParser) addAttachment(sel *goquery.Selection) {
+	if img := sel.Find("img[zoomfile], img[file]"); img.Length() > 0 {
+		p.addImage(img.First())
+		p.endParagraph()
+		return
+	}
+	link := sel.Find("a[href*='attachment']").First()
+	if link.Length() == 0 {
+		return
+	}
+	href, _ := link.Attr("href")
+	p.blocks = append(p.blocks, Block{
+		Kind: AttachmentBlock,
+		Name: strings.TrimSpace(link.Text()),
+		URL:  href,
+	})
+}
+
+func (p *contentParser) endParagraph() {
+	if len(p.paragraph) > 0 {
+		first, last := &p.paragraph[0], &p.paragraph[len(p.paragraph)-1]
+		if first.Kind == TextInline {
+			first.Text = strings.TrimLeft(first.Text, " ")
+		}
+		if last.Kind == TextInline {
+			last.Text = strings.TrimRight(last.Text, " ")
+		}
+	}
+	p.blocks = append(p.blocks, Block{Kind: ParagraphBlock, Inlines: p.paragraph})
+	p.paragraph = nil
+}
+
+// parseQuote parses a <blockquote>, whose first line names the quoted author.
+func parseQuote(node *html.Node) Block {
+	quote := Block{Kind: QuoteBlock}
+	children := []*html.Node{}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		children = append(children, child)
+	}
+	quote.Blocks = removeEmptyParagraphs(parseBlocks(children))
+	if len(quote.Blocks) > 0 {
+		if match := quoteHeaderPattern.FindStringSubmatch(plainText(quote.Blocks[0])); match != nil {
+			quote.QuoteAuthor = match[1]
+			quote.Blocks = quote.Blocks[1:]
+		}
+	}
+	return quote
+}
+
+// groupQuotes turns archiver quotes, which are plain text starting with
+// "author 发表于 time" and ending with an empty line, into quote blocks.
+func groupQuotes(blocks []Block) (result []Block) {
+	for i := 0; i < len(blocks); i++ {
+		if blocks[i].Kind != ParagraphBlock {
+			result = append(result, blocks[i])
+			continue
+		}
+		match := quoteHeaderPattern.FindStringSubmatch(plainText(blocks[i]))
+		if match == nil {
+			result = append(result, blocks[i])
+			continue
+		}
+		quote := Block{Kind: QuoteBlock, QuoteAuthor: match[1]}
+		for i++; i < len(blocks) && !isEmptyParagraph(blocks[i]); i++ {
+			quote.Blocks = append(quote.Blocks, blocks[i])
+		}
+		result = append(result, quote)
+	}
+	return
+}
+
+func removeEmptyParagraphs(blocks []Block) (result []Block) {
+	for _, block := range blocks {
+		if !isEmptyParagraph(block) {
+			result = append(result, block)
+		}
+	}
+	return
+}
+
+func isEmptyParagraph(block Block) bool {
+	return block.Kind == ParagraphBlock && len(strings.TrimSpace(plainText(block))) == 0 &&
+		!hasMedia(block)
+}
+
+func hasMedia(block Block) bool {
+	for _, inline := range block.Inlines {
+		if inline.Kind != TextInline {
+			return true
+		}
+	}
+	return false
+}
+
+func plainText(block Block) string {
+	buf := []string{}
+	for _, inline := range block.Inlines {
+		buf = append(buf, inline.Text)
+	}
+	return strings.Join(buf, "")
+}
+
+// PlainText renders blocks as text, one paragraph per line.
+func PlainText(blocks []Block) string {
+	lines := []string{}
+	for _, block := range blocks {
+		switch block.Kind {
+		case ParagraphBlock:
+			lines = append(lines, plainText(block))
+		case QuoteBlock:
+			if len(block.QuoteAuthor) > 0 {
+				lines = append(lines, block.QuoteAuthor+":")
+			}
+			lines = append(lines, PlainText(block.Blocks))
+		case AttachmentBlock:
+			lines = append(lines, block.Name)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// Kinds of errors returned by S1Client, use Kind to find the kind of an error.
+var (
+	ErrNetwork          = errors.New("network error")
+	ErrHTTPStatus       = errors.New("unexpected HTTP status")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrParse            = errors.New("parse error")
+	ErrNotLoggedIn      = errors.New("login required")
+	ErrLoginFailed      = errors.New("login failed")
+	ErrCaptchaRequired  = errors.New("captcha required")
+)
+
+// Error is an error returned by S1Client.
+type Error struct {
+	// Kind is one of the ErrXxx errors above.
+	Kind error
+	URL  string
+	// StatusCode is the HTTP status of the response, 0 if there is none.
+	StatusCode int
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%s: %v", e.URL, e.Kind)
+	}
+	return fmt.Sprintf("%s: %v: %v", e.URL, e.Kind, e.Err)
+}
+
+// Temporary reports whether retrying the request may succeed.
+func (e *Error) Temporary() bool {
+	switch e.Kind {
+	case ErrNetwork:
+		return true
+	case ErrHTTPStatus:
+		return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+	}
+	return false
+}
+
+// Kind returns the kind of an error returned by S1Client, or nil if err isn't
+// one.
+func Kind(err error) error {
+	if e, ok := err.(*Error); ok {
+		return e.Kind
+	}
+	return nil
+}
+
+// IsTemporary reports whether err is an S1Client error worth retrying.
+func IsTemporary(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.Temporary()
+}
+
+// statusError classifies a response with a non 2xx status.
+func statusError(url string, resp *http.Response) error {
+	kind := ErrHTTPStatus
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		kind = ErrNotLoggedIn
+	case http.StatusForbidden:
+		kind = ErrPermissionDenied
+	}
+	return &Error{Kind: kind, URL: url, StatusCode: resp.StatusCode, Err: errors.New(resp.Status)}
+}
+
+// RetryPolicy controls how S1Client retries temporary errors.
+type RetryPolicy struct {
+	// MaxAttempts is the number of tries including the first, 0 means 1.
+	MaxAttempts int
+	// The n-th retry waits a random time between half and all of
+	// BaseDelay * 2^(n-1), capped at MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// DefaultRetryPolicy retries a few times over about half a minute.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   2 * time.Second,
+	MaxDelay:    30 * time.Second,
+}
+
+// delay returns the jittered delay before the retry-th retry.
+func (p RetryPolicy) delay(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ForumParser parses the regular forum.php pages, which carry post IDs, user
+// IDs, ratings, signatures and sticky flags that the archiver drops.
+type ForumParser struct{}
+
+var (
+	forumLinkPattern = regexp.MustCompile("forum-(\\d+)-\\d+\\.html")
+	spaceLinkPattern = regexp.MustCompile("space-uid-(\\d+)\\.html")
+	numberPattern    = regexp.MustCompile("[+-]?\\s*\\d+")
+)
+
+func (ForumParser) ForumsPath() string {
+	return "forum.php"
+}
+
+func (ForumParser) ThreadsPath(forum Forum, page int) string {
+	return fmt.Sprintf("forum.php?mod=forumdisplay&fid=%d&page=%d", forum.ID, page)
+}
+
+func (ForumParser) PostsPath(thread Thread, page int) string {
+	return fmt.Sprintf("forum.php?mod=viewthread&tid=%d&page=%d", thread.ID, page)
+}
+
+func (ForumParser) ParseForums(r io.Reader) (forums []Forum, err error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return
+	}
+
+	nodes := doc.Find(".fl_tb dt a, .fl_tb h2 a")
+	for i := range nodes.Nodes {
+		node := nodes.Eq(i)
+		link, _ := node.Attr("href")
+		id := forumID(link)
+		if id == 0 {
+			err = fmt.Errorf("Cannot find forum id in %q", link)
+			return
+		}
+		forums = append(forums, Forum{Title: node.Text(), ID: id})
+	}
+	return
+}
+
+func (ForumParser) ParseThreads(r io.Reader, forum Forum) (threads []Thread, err error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return
+	}
+
+	nodes := doc.Find("#threadlisttableid tbody[id^=stickthread_], #threadlisttableid tbody[id^=normalthread_]")
+	for i := range nodes.Nodes {
+		node := nodes.Eq(i)
+		id, _ := node.Attr("id")
+		title := node.Find("a.xst")
+		if title.Length() == 0 {
+			err = errors.New("Cannot find thread link")
+			return
+		}
+		author := node.Find("td.by").First().Find("cite a")
+		authorLink, _ := author.Attr("href")
+		reply, _ := strconv.Atoi(strings.TrimSpace(node.Find("td.num a").Text()))
+
+		threads = append(threads, Thread{
+			Forum:    forum,
+			Title:    title.Text(),
+			ID:       findIntAndParse(id),
+			Reply:    reply,
+			Author:   strings.TrimSpace(author.Text()),
+			AuthorID: userID(authorLink),
+			Sticky:   strings.HasPrefix(id, "stickthread_"),
+		})
+	}
+	return
+}
+
+func (ForumParser) ParsePosts(r io.Reader, thread Thread, page int) (posts []*Post, err error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	nodes := doc.Find("#postlist > div[id^=post_]")
+	for i := range nodes.Nodes {
+		node := nodes.Eq(i)
+		id, _ := node.Attr("id")
+		if id == "post_new" {
+			continue
+		}
+		pid := findIntAndParse(id)
+
+		author := node.Find(".pls .authi a").First()
+		authorLink, _ := author.Attr("href")
+
+		postTimeStr := node.Find(fmt.Sprintf("#authorposton%d span[title]", pid)).AttrOr("title", "")
+		if len(postTimeStr) == 0 {
+			postTimeStr = strings.TrimPrefix(node.Find(fmt.Sprintf("#authorposton%d", pid)).Text(), "发表于")
+		}
+		postTime, err := parseTime(postTimeStr)
+		if err != nil {
+			return posts, err
+		}
+
+		floor := (page-1)*PostsPerPage + len(posts) + 1
+		if f, err := strconv.Atoi(node.Find(fmt.Sprintf("#postnum%d em", pid)).Text()); err == nil {
+			floor = f
+		}
+
+		message := node.Find(".t_f")
+		contentNodes := append(message.Contents().Nodes, node.Find(".pattl").Nodes...)
+		blocks := parseContent(contentNodes)
+		post := &Post{
+			ID:        pid,
+			Floor:     floor,
+			Author:    strings.TrimSpace(author.Text()),
+			AuthorID:  userID(authorLink),
+			PostTime:  postTime,
+			Content:   PlainText(blocks),
+			Blocks:    blocks,
+			Signature: strings.TrimSpace(node.Find(".sign").Text()),
+			Ratings:   parseRatings(node.Find(".ratl_l tr")),
+			Thread:    thread,
+		}
+		parseEditBanner(post)
+		posts = append(posts, post)
+	}
+	return posts, nil
+}
+
+func parseRatings(rows *goquery.Selection) (ratings []Rating) {
+	for i := range rows.Nodes {
+		row := rows.Eq(i)
+		cells := row.Find("td")
+		user := cells.Eq(0).Find("a").Last()
+		link, _ := user.Attr("href")
+		score, _ := strconv.Atoi(strings.Replace(numberPattern.FindString(cells.Eq(1).Text()), " ", "", -1))
+		ratings = append(ratings, Rating{
+			User:   strings.TrimSpace(user.Text()),
+			UserID: userID(link),
+			Score:  score,
+			Reason: strings.TrimSpace(cells.Eq(2).Text()),
+		})
+	}
+	return
+}
+
+func forumID(link string) int {
+	if match := forumLinkPattern.FindStringSubmatch(link); match != nil {
+		id, _ := strconv.Atoi(match[1])
+		return id
+	}
+	return findParam(link, "fid")
+}
+
+func userID(link string) int {
+	if match := spaceLinkPattern.FindStringSubmatch(link); match != nil {
+		id, _ := strconv.Atoi(match[1])
+		return id
+	}
+	return findParam(link, "uid")
+}
@@ -0,0 +1,233 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	loginFormPath = "member.php?mod=logging&action=login"
+	// cookieTime keeps us logged in for 30 days.
+	cookieTime = "2592000"
+)
+
+var ajaxMessagePattern = regexp.MustCompile("<!\\[CDATA\\[([^<\\]]*)")
+
+// Credentials are what Login submits to the login form.
+type Credentials struct {
+	Username string
+	Password string
+	// QuestionID and Answer answer the security question of the account,
+	// QuestionID is 0 if the account has none.
+	QuestionID int
+	Answer     string
+}
+
+// Captcha is the seccode image shown by the login form.
+type Captcha struct {
+	Image []byte
+}
+
+// CaptchaSolver returns the text in a captcha, e.g. by asking a human.
+type CaptchaSolver func(captcha Captcha) (string, error)
+
+// loginForm holds what we need from the login form.
+type loginForm struct {
+	action string
+	values url.Values
+	// seccodeHash and seccodeImage are set if the form asks for a captcha.
+	seccodeHash  string
+	seccodeImage string
+}
+
+// Login retrieve S1 Cookie by simulate user's login. S1Client will use the same
+// cookie for following requests, save it to SessionFile and log in again with
+// the same credentials when the session expires. If the session loaded by
+// LoadSession belongs to username, the credentials are not posted again.
+func (s *S1Client) Login(username string, password string) (err error) {
+	return s.LoginWith(Credentials{Username: username, Password: password})
+}
+
+// LoginContext is Login that gives up when ctx is done.
+func (s *S1Client) LoginContext(ctx context.Context, username string, password string) (err error) {
+	return s.LoginWithContext(ctx, Credentials{Username: username, Password: password})
+}
+
+// LoginWith is Login for accounts with a security question.
+func (s *S1Client) LoginWith(credentials Credentials) error {
+	return s.LoginWithContext(context.Background(), credentials)
+}
+
+// LoginWithContext is LoginWith that gives up when ctx is done.
+func (s *S1Client) LoginWithContext(ctx context.Context, credentials Credentials) error {
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+	s.credentials = credentials
+	s.loginErr = nil
+	if s.sessionUser == credentials.Username && s.LoggedIn() {
+		return nil
+	}
+	return s.login(ctx, s.CaptchaSolver)
+}
+
+// login fills the login form with the credentials given to Login and submits
+// it, the caller must hold loginMu. A captcha is solved by solver, it fails
+// with ErrCaptchaRequired if solver is nil. Rejected credentials and captchas
+// are recorded in s.loginErr.
+func (s *S1Client) login(ctx context.Context, solver CaptchaSolver) (err error) {
+	defer func() {
+		if kind := Kind(err); kind == ErrLoginFailed || kind == ErrCaptchaRequired {
+			s.loginErr = err
+		}
+	}()
+	form, err := s.getLoginForm(ctx)
+	if err != nil {
+		return err
+	}
+	values := form.values
+	values.Set("loginfield", "username")
+	values.Set("username", s.credentials.Username)
+	values.Set("password", s.credentials.Password)
+	values.Set("questionid", strconv.Itoa(s.credentials.QuestionID))
+	values.Set("answer", s.credentials.Answer)
+	values.Set("cookietime", cookieTime)
+	values.Set("loginsubmit", "true")
+	if len(form.seccodeHash) > 0 {
+		answer, err := s.solveCaptcha(ctx, form, solver)
+		if err != nil {
+			return err
+		}
+		values.Set("seccodeverify", answer)
+	}
+
+	path := form.action + "&inajax=1"
+	resp, err := s.request(ctx, "POST", path, values)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == authCookie && len(cookie.Value) > 0 && cookie.MaxAge >= 0 {
+			s.generation++
+			s.loginErr = nil
+			s.sessionUser = s.credentials.Username
+			s.setSessionBase(s.baseURL())
+			s.cookies = map[string]*http.Cookie{}
+			now := time.Now()
+			for _, cookie := range resp.Cookies() {
+				if cookie.MaxAge > 0 {
+					cookie.Expires = now.Add(time.Duration(cookie.MaxAge) * time.Second)
+				}
+				s.cookies[cookie.Name] = cookie
+			}
+			return s.saveSession()
+		}
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return &Error{Kind: ErrNetwork, URL: s.baseURL() + path, Err: err}
+	}
+	kind := ErrLoginFailed
+	message := loginMessage(body)
+	// A wrong answer, "验证码填写错误", failed like a wrong password.
+	if strings.Contains(message, "验证码") && !strings.Contains(message, "错误") {
+		kind = ErrCaptchaRequired
+	}
+	return &Error{Kind: kind, URL: s.baseURL() + path, Err: errors.New(message)}
+}
+
+// getLoginForm fetches the login form for its formhash and captcha.
+func (s *S1Client) getLoginForm(ctx context.Context) (form loginForm, err error) {
+	body, err := s.getAll(ctx, loginFormPath)
+	if err != nil {
+		return
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	node := doc.Find("form[name=login]").First()
+	action, found := node.Attr("action")
+	if !found {
+		err = &Error{Kind: ErrParse, URL: s.baseURL() + loginFormPath, Err: errors.New("Cannot find login form")}
+		return
+	}
+
+	form.action = action
+	form.values = url.Values{}
+	inputs := node.Find("input[type=hidden]")
+	for i := range inputs.Nodes {
+		input := inputs.Eq(i)
+		if name, found := input.Attr("name"); found {
+			form.values.Set(name, input.AttrOr("value", ""))
+		}
+	}
+	if form.seccodeHash = form.values.Get("seccodehash"); len(form.seccodeHash) > 0 {
+		form.seccodeImage = node.Find(fmt.Sprintf("#vseccode_%s img", form.seccodeHash)).AttrOr("src", "")
+		if len(form.seccodeImage) == 0 {
+			form.seccodeImage = fmt.Sprintf("misc.php?mod=seccode&update=%d&idhash=%s", time.Now().Unix(), form.seccodeHash)
+		}
+	}
+	return
+}
+
+// solveCaptcha downloads the captcha of form and asks solver for it. The
+// image may be on another host than the form.
+func (s *S1Client) solveCaptcha(ctx context.Context, form loginForm, solver CaptchaSolver) (string, error) {
+	if solver == nil {
+		return "", &Error{Kind: ErrCaptchaRequired, URL: s.baseURL() + loginFormPath}
+	}
+	base, err := url.Parse(s.baseURL())
+	if err != nil {
+		return "", err
+	}
+	ref, err := url.Parse(form.seccodeImage)
+	if err != nil {
+		return "", &Error{Kind: ErrParse, URL: s.baseURL() + loginFormPath, Err: err}
+	}
+	image, err := s.download(ctx, base.ResolveReference(ref).String())
+	if err != nil {
+		return "", err
+	}
+	return solver(Captcha{Image: image})
+}
+
+// download reads the absolute URL u.
+func (s *S1Client) download(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	} else if err != nil {
+		return nil, &Error{Kind: ErrNetwork, URL: u, Err: err}
+	}
+	return content, nil
+}
+
+// loginMessage returns the message of an inajax response to the login form.
+func loginMessage(body []byte) string {
+	if match := ajaxMessagePattern.FindSubmatch(body); match != nil {
+		return strings.TrimSpace(string(match[1]))
+	}
+	return strings.TrimSpace(string(body))
+}
@@ -0,0 +1,57 @@
+package client
+
+import "net/http"
+
+// allBases returns BaseURL followed by Mirrors.
+func (s *S1Client) allBases() []string {
+	all := []string{DefaultBaseURL}
+	if len(s.BaseURL) > 0 {
+		all[0] = s.BaseURL
+	}
+	return append(all, s.Mirrors...)
+}
+
+// bases returns BaseURL and Mirrors in the order to try them, starting from
+// the one that answered last.
+func (s *S1Client) bases() []string {
+	all := s.allBases()
+	s.mirrorMu.Lock()
+	active := s.active
+	s.mirrorMu.Unlock()
+	if active >= len(all) {
+		active = 0
+	}
+	return append(all[active:len(all):len(all)], all[:active]...)
+}
+
+// baseURL returns the root of the forum we are talking to.
+func (s *S1Client) baseURL() string {
+	return s.bases()[0]
+}
+
+// useBase makes requests go to base until it becomes unreachable.
+func (s *S1Client) useBase(base string) {
+	for i, b := range s.allBases() {
+		if b == base {
+			s.mirrorMu.Lock()
+			s.active = i
+			s.mirrorMu.Unlock()
+			return
+		}
+	}
+}
+
+// unreachable reports whether err means we should try another mirror.
+func unreachable(err error) bool {
+	e, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+	switch e.Kind {
+	case ErrNetwork:
+		return true
+	case ErrHTTPStatus:
+		return e.StatusCode == http.StatusBadGateway || e.StatusCode == http.StatusGatewayTimeout
+	}
+	return false
+}
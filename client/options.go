@@ -0,0 +1,130 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// ClientOptions configures the HTTP client built by NewS1ClientWithOptions.
+// The zero value verifies certificates against the system CAs and uses the
+// proxy from the environment.
+type ClientOptions struct {
+	// BaseURL is the root of the forum, e.g. a local mirror. DefaultBaseURL
+	// if empty.
+	BaseURL string
+	// Mirrors are other roots of the forum tried when BaseURL is
+	// unreachable.
+	Mirrors []string
+	// InsecureSkipVerify disables verification of TLS certificates.
+	InsecureSkipVerify bool
+	// RootCAs verifies TLS certificates instead of the system CAs if not nil,
+	// see LoadCertPool.
+	RootCAs *x509.CertPool
+	// Proxy is a http://, https:// or socks5:// URL. HTTP_PROXY and
+	// HTTPS_PROXY are used if empty.
+	Proxy string
+	// Timeout limits each request including reading the body, no limit if 0.
+	Timeout time.Duration
+	// UserAgent replaces the User-Agent of Go if not empty.
+	UserAgent string
+}
+
+// NewS1ClientWithOptions creates a new S1 client configured by options.
+func NewS1ClientWithOptions(options ClientOptions) (*S1Client, error) {
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := normalizeBaseURL(options.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	var mirrors []string
+	for _, mirror := range options.Mirrors {
+		if mirror, err = normalizeBaseURL(mirror); err != nil {
+			return nil, err
+		}
+		mirrors = append(mirrors, mirror)
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if len(options.Proxy) > 0 {
+		u, err := url.Parse(options.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("Unsupported proxy %q", options.Proxy)
+		}
+		proxy = http.ProxyURL(u)
+	}
+
+	tr := &http.Transport{
+		Proxy: proxy,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: options.InsecureSkipVerify,
+			RootCAs:            options.RootCAs,
+		},
+		TLSHandshakeTimeout: 10 * time.Second,
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	httpClient := &http.Client{
+		Jar:       jar,
+		Transport: tr,
+		Timeout:   options.Timeout,
+	}
+	return &S1Client{
+		HttpClient: httpClient,
+		BaseURL:    base,
+		Mirrors:    mirrors,
+		UserAgent:  options.UserAgent,
+		Limiter:    NewRateLimiter(DefaultRateLimit),
+		Retry:      DefaultRetryPolicy,
+	}, nil
+}
+
+// normalizeBaseURL checks base is a http or https URL and makes it end with
+// "/". Empty base stays empty.
+func normalizeBaseURL(base string) (string, error) {
+	if len(base) == 0 {
+		return base, nil
+	}
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("Base URL %q is not http or https", base)
+	}
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	return base, nil
+}
+
+// LoadCertPool reads PEM encoded CA certificates from file.
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("No certificate in " + file)
+	}
+	return pool, nil
+}
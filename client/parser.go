@@ -0,0 +1,105 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Parser knows where forums, threads and posts live on the site and how to
+// extract them from those pages. Paths are relative to the base URL.
+type Parser interface {
+	ForumsPath() string
+	ThreadsPath(forum Forum, page int) string
+	PostsPath(thread Thread, page int) string
+
+	ParseForums(r io.Reader) ([]Forum, error)
+	ParseThreads(r io.Reader, forum Forum) ([]Thread, error)
+	ParsePosts(r io.Reader, thread Thread, page int) ([]*Post, error)
+}
+
+// NewParser returns the parser registered under name: "archiver" for the
+// lightweight archiver pages, "forum" for the regular forum pages or "api" for
+// the mobile JSON API.
+func NewParser(name string) (Parser, error) {
+	switch name {
+	case "", "archiver":
+		return ArchiverParser{}, nil
+	case "forum":
+		return ForumParser{}, nil
+	case "api":
+		return APIParser{}, nil
+	}
+	return nil, fmt.Errorf("Unknown parser %q", name)
+}
+
+var (
+	editBannerPattern = regexp.MustCompile("本帖最后由 (.+?) 于 (\\d+-\\d+-\\d+ \\d+:\\d+) 编辑")
+	tzshanghai, _     = time.LoadLocation("Asia/Shanghai")
+)
+
+// parseTime parses time displayed by S1, which is always in China Standard Time.
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	layout := "2006-1-2 15:04"
+	if strings.Count(s, ":") == 2 {
+		layout = "2006-1-2 15:04:05"
+	}
+	return time.ParseInLocation(layout, s, tzshanghai)
+}
+
+// parseEditBanner finds the "本帖最后由 ... 编辑" banner in the content of a post.
+func parseEditBanner(post *Post) {
+	match := editBannerPattern.FindStringSubmatch(post.Content)
+	if match == nil {
+		return
+	}
+	if editedAt, err := parseTime(match[2]); err == nil {
+		post.EditedBy = match[1]
+		post.EditedAt = editedAt
+	}
+}
+
+// findParam returns the integer value of a query parameter in link, or 0.
+func findParam(link, name string) int {
+	pattern := regexp.MustCompile("[?&;]" + name + "=(\\d+)")
+	match := pattern.FindStringSubmatch(link)
+	if match == nil {
+		return 0
+	}
+	result, _ := strconv.Atoi(match[1])
+	return result
+}
+
+// checkMessagePage returns ErrNotLoggedIn or ErrPermissionDenied when body is a
+// Discuz showmessage page or a login form instead of the page we asked for.
+// Parsers would find nothing on those pages and return no error.
+func checkMessagePage(body []byte) error {
+	if !bytes.Contains(body, []byte("id=\"messagetext\"")) && !bytes.Contains(body, []byte("id=\"loginform_")) {
+		return nil
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	message := strings.TrimSpace(doc.Find("#messagetext p").First().Text())
+	var cause error
+	if len(message) > 0 {
+		cause = errors.New(message)
+	}
+	// Guests are told their user group (游客) can't see the page.
+	if doc.Find("#messagelogin, form[id^=loginform_]").Length() > 0 || strings.Contains(message, "(游客)") {
+		return &Error{Kind: ErrNotLoggedIn, Err: cause}
+	}
+	if doc.Find("#messagetext").Length() > 0 {
+		return &Error{Kind: ErrPermissionDenied, Err: cause}
+	}
+	return nil
+}
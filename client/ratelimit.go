@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit configures how politely S1Client talks to the site. Zero rates
+// and limits mean unlimited.
+type RateLimit struct {
+	// Rate and Burst limit requests per second to all hosts.
+	Rate  float64
+	Burst int
+	// HostRate and HostBurst limit requests per second to a single host.
+	HostRate  float64
+	HostBurst int
+	// MaxConcurrent caps the number of requests in flight.
+	MaxConcurrent int
+	// MaxBackoff caps how long a host is paused after it answers 429 or 503
+	// without a Retry-After header.
+	MaxBackoff time.Duration
+}
+
+// DefaultRateLimit is slow enough to not get our account or IP banned.
+var DefaultRateLimit = RateLimit{
+	Rate:          2,
+	Burst:         4,
+	HostRate:      2,
+	HostBurst:     4,
+	MaxConcurrent: 4,
+	MaxBackoff:    5 * time.Minute,
+}
+
+const initialBackoff = time.Second
+
+// RateLimiter is a global and per-host token bucket limiter that also pauses
+// hosts asking us to slow down.
+type RateLimiter struct {
+	config RateLimit
+	slots  chan struct{}
+
+	mu     sync.Mutex
+	global *tokenBucket
+	hosts  map[string]*hostState
+	now    func() time.Time
+}
+
+type hostState struct {
+	bucket      *tokenBucket
+	pausedUntil time.Time
+	backoff     time.Duration
+}
+
+// NewRateLimiter creates a limiter with config.
+func NewRateLimiter(config RateLimit) *RateLimiter {
+	l := &RateLimiter{
+		config: config,
+		global: newTokenBucket(config.Rate, config.Burst),
+		hosts:  map[string]*hostState{},
+		now:    time.Now,
+	}
+	if config.MaxConcurrent > 0 {
+		l.slots = make(chan struct{}, config.MaxConcurrent)
+	}
+	return l
+}
+
+// Acquire blocks until a request to host is allowed. The returned function
+// must be called once the request is done.
+func (l *RateLimiter) Acquire(host string) (release func()) {
+	release, _ = l.AcquireContext(context.Background(), host)
+	return
+}
+
+// AcquireContext is Acquire that gives up when ctx is done, in which case
+// release is nil and the tokens taken for the request are given back.
+func (l *RateLimiter) AcquireContext(ctx context.Context, host string) (release func(), err error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release = func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+	if err = sleep(ctx, l.reserve(host)); err != nil {
+		l.unreserve(host)
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// reserve takes a token from the global and the host bucket and returns how
+// long to wait until both are available and the host is no longer paused.
+func (l *RateLimiter) reserve(host string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	state := l.host(host)
+	wait := l.global.reserve(now)
+	if hostWait := state.bucket.reserve(now); hostWait > wait {
+		wait = hostWait
+	}
+	if paused := state.pausedUntil.Sub(now); paused > wait {
+		wait = paused
+	}
+	return wait
+}
+
+// unreserve gives back the tokens taken by reserve for a request to host that
+// wasn't sent.
+func (l *RateLimiter) unreserve(host string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.global.unreserve()
+	l.host(host).bucket.unreserve()
+}
+
+// Observe updates the backoff of host from the response to a request.
+func (l *RateLimiter) Observe(host string, resp *http.Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	state := l.host(host)
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		state.backoff = 0
+		return
+	}
+
+	now := l.now()
+	pause, found := retryAfter(resp, now)
+	if !found {
+		if state.backoff == 0 {
+			state.backoff = initialBackoff
+		} else {
+			state.backoff *= 2
+		}
+		if l.config.MaxBackoff > 0 && state.backoff > l.config.MaxBackoff {
+			state.backoff = l.config.MaxBackoff
+		}
+		pause = state.backoff
+	}
+	if until := now.Add(pause); until.After(state.pausedUntil) {
+		state.pausedUntil = until
+	}
+}
+
+func (l *RateLimiter) host(host string) *hostState {
+	state, found := l.hosts[host]
+	if !found {
+		state = &hostState{bucket: newTokenBucket(l.config.HostRate, l.config.HostBurst)}
+		l.hosts[host] = state
+	}
+	return state
+}
+
+// retryAfter parses the Retry-After header, which is either seconds or a date.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if len(value) == 0 {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return date.Sub(now), true
+	}
+	return 0, false
+}
+
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst)}
+}
+
+// reserve takes a token, possibly going into debt, and returns how long to
+// wait until the debt is paid.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if b.rate <= 0 {
+		return 0
+	}
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// unreserve gives back a token taken by reserve.
+func (b *tokenBucket) unreserve() {
+	if b.rate <= 0 {
+		return
+	}
+	if b.tokens++; b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
@@ -2,27 +2,25 @@ package client
 
 import (
 	"bytes"
-	"crypto/tls"
-	"errors"
+	"context"
 	"fmt"
-	"github.com/PuerkitoBio/goquery"
-	"golang.org/x/net/html"
-	"golang.org/x/net/publicsuffix"
+	"io"
+	"io/ioutil"
 	"net/http"
-	"net/http/cookiejar"
 	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
 const (
-	baseURL           = "https://bbs.saraba1st.com/2b/"
-	loginURL          = baseURL + "member.php?mod=logging&action=login&loginsubmit=yes&infloat=yes&lssubmit=yes&inajax=1"
-	frontPageURL      = baseURL + "archiver/"
-	forumURLTemplate  = baseURL + "archiver/fid-%d.html?page=%d"
-	threadURLTemplate = baseURL + "archiver/tid-%d.html?page=%d"
-	authCookie        = "B7Y9_2132_auth"
+	// DefaultBaseURL is where S1 lives.
+	DefaultBaseURL = "https://bbs.saraba1st.com/2b/"
+	authCookie     = "B7Y9_2132_auth"
+	// PostsPerPage is the number of posts in a page of a thread.
+	PostsPerPage = 30
 )
 
 // Forum represents a S1 Forum.
@@ -31,169 +29,327 @@ type Forum struct {
 	ID    int
 }
 
-// Thread represents a discussion thread in a forum.
+// Thread represents a discussion thread in a forum. Author, AuthorID and
+// Sticky are only known by parsers of the full forum pages.
 type Thread struct {
-	Title string
-	ID    int
-	Reply int
-	Forum Forum
+	Title    string
+	ID       int
+	Reply    int
+	Forum    Forum
+	Author   string
+	AuthorID int
+	Sticky   bool
 }
 
-// Post represents a post in a thread.
+// Post represents a post in a thread. ID, Floor, AuthorID, Ratings and
+// Signature are zero when the page doesn't expose them.
 type Post struct {
+	ID       int
+	Floor    int
 	Author   string
+	AuthorID int
 	PostTime time.Time
-	Content  string
+	// Content is the plain text of the post and Blocks its structure.
+	Content   string
+	Blocks    []Block
+	Signature string
+	Ratings   []Rating
+	// EditedBy and EditedAt come from the "本帖最后由 ... 编辑" banner.
+	EditedBy string
+	EditedAt time.Time
 	Thread   Thread
 }
 
+// Rating is a score given to a post by another user.
+type Rating struct {
+	User   string
+	UserID int
+	Score  int
+	Reason string
+}
+
 // S1Client helps us interact with s1 backend with a presistant cookie.
 type S1Client struct {
 	HttpClient *http.Client
-	Cookies    []*http.Cookie
+	// BaseURL is the root of the forum ending with "/", DefaultBaseURL if
+	// empty.
+	BaseURL string
+	// Mirrors are other roots of the forum ending with "/", used when BaseURL
+	// is unreachable. Cookies are per domain, so each mirror needs its own
+	// login.
+	Mirrors []string
+	// UserAgent is sent with every request if not empty.
+	UserAgent string
+	// Parser picks the kind of pages to scrape, ArchiverParser if nil.
+	Parser Parser
+	// Limiter throttles requests, no limit if nil.
+	Limiter *RateLimiter
+	// Retry controls retries of temporary errors, no retry if zero.
+	Retry RetryPolicy
+	// SessionFile keeps the session cookies across restarts, see LoadSession.
+	SessionFile string
+	// CaptchaSolver solves captchas asked by the login form on Login, which
+	// fails with ErrCaptchaRequired if it's nil. Logging in again when the
+	// session expires never asks it.
+	CaptchaSolver CaptchaSolver
+
+	mirrorMu sync.Mutex
+	// active is the index of the base URL that answered last, 0 is BaseURL
+	// and i is Mirrors[i-1].
+	active int
+	// sessionBase is the base URL the session cookies were issued for.
+	sessionBase string
+
+	loginMu     sync.Mutex
+	credentials Credentials
+	// sessionUser is the user the cookies in the jar belong to.
+	sessionUser string
+	// cookies are the cookies of the session with their attributes, which the
+	// jar doesn't give back.
+	cookies map[string]*http.Cookie
+	// generation increases whenever we log in.
+	generation int
+	// loginErr is why logging in with credentials failed in this generation.
+	// We fetch as a guest and don't log in again until Login is called.
+	loginErr error
 }
 
-// NewS1Client creates a new S1 client.
+// NewS1Client creates a new S1 client with default options.
 func NewS1Client() *S1Client {
-	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	client, err := NewS1ClientWithOptions(ClientOptions{})
 	if err != nil {
 		panic(err)
 	}
+	return client
+}
 
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-	}
+// GetForums returns forums that are visiable to this user.
+func (s *S1Client) GetForums() ([]Forum, error) {
+	return s.GetForumsContext(context.Background())
+}
 
-	httpClient := &http.Client{
-		Jar:       jar,
-		Transport: tr,
-	}
-	return &S1Client{HttpClient: httpClient}
-}
-
-// Login retrieve S1 Cookie by simulate user's login. S1Client will use the same
-// cookie for following requests.
-func (s *S1Client) Login(username string, password string) (err error) {
-	data := url.Values{
-		"username":       {username},
-		"password":       {password},
-		"fastloginfield": {"username"},
-		"quickforward":   {"yes"},
-		"handlekey":      {"ls"},
-	}
+// GetForumsContext is GetForums that gives up when ctx is done.
+func (s *S1Client) GetForumsContext(ctx context.Context) (forums []Forum, err error) {
+	err = s.fetch(ctx, s.parser().ForumsPath(), func(body io.Reader) (err error) {
+		forums, err = s.parser().ParseForums(body)
+		return
+	})
+	return
+}
 
-	resp, err := s.HttpClient.PostForm(loginURL, data)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+// GetThreads returns threads in some forum at some page.
+func (s *S1Client) GetThreads(forum Forum, page int) ([]Thread, error) {
+	return s.GetThreadsContext(context.Background(), forum, page)
+}
 
-	for _, cookie := range resp.Cookies() {
-		if cookie.Name == authCookie {
-			s.Cookies = resp.Cookies()
-			return nil
-		}
-	}
-	return errors.New("Login Failed")
+// GetThreadsContext is GetThreads that gives up when ctx is done.
+func (s *S1Client) GetThreadsContext(ctx context.Context, forum Forum, page int) (threads []Thread, err error) {
+	err = s.fetch(ctx, s.parser().ThreadsPath(forum, page), func(body io.Reader) (err error) {
+		threads, err = s.parser().ParseThreads(body, forum)
+		return
+	})
+	return
 }
 
-// GetForums returns forums that are visiable to this user.
-func (s *S1Client) GetForums() (forums []Forum, err error) {
-	doc, err := s.getAndParase(frontPageURL)
-	if err != nil {
+// GetPosts returns posts of some thread at some page.
+func (s *S1Client) GetPosts(thread Thread, page int) ([]*Post, error) {
+	return s.GetPostsContext(context.Background(), thread, page)
+}
+
+// GetPostsContext is GetPosts that gives up when ctx is done.
+func (s *S1Client) GetPostsContext(ctx context.Context, thread Thread, page int) (posts []*Post, err error) {
+	err = s.fetch(ctx, s.parser().PostsPath(thread, page), func(body io.Reader) (err error) {
+		posts, err = s.parser().ParsePosts(body, thread, page)
 		return
+	})
+	return
+}
+
+func (s *S1Client) parser() Parser {
+	if s.Parser == nil {
+		return ArchiverParser{}
 	}
+	return s.Parser
+}
 
-	forumNodes := doc.Find("#content a")
-	for i := range forumNodes.Nodes {
-		node := forumNodes.Eq(i)
+// fetch gets a page relative to the base URL and parses it, retrying
+// temporary errors according to s.Retry and logging in again once if the
+// session has expired. If logging in fails, pages are fetched as a guest and
+// those requiring login fail with the error of the login. Discuz message
+// pages are returned as ErrPermissionDenied or ErrNotLoggedIn and errors of
+// parse as ErrParse. If ctx is done, its error is returned as is.
+func (s *S1Client) fetch(ctx context.Context, path string, parse func(io.Reader) error) (err error) {
+	relogged := false
+	for attempt := 1; ; attempt++ {
+		generation, canLogin := s.sessionState()
+		if canLogin && !s.LoggedIn() {
+			if err = s.relogin(ctx, generation); err != nil && s.loginError() == nil {
+				return
+			}
+			generation, canLogin = s.sessionState()
+		}
 
-		link, found := node.Attr("href")
-		if !found {
-			err = errors.New("Cannot find forum link")
-			return
+		var body []byte
+		body, err = s.getAll(ctx, path)
+		if err == nil {
+			err = checkMessagePage(body)
+		}
+		if Kind(err) == ErrNotLoggedIn {
+			if loginErr := s.loginError(); loginErr != nil {
+				return loginErr
+			}
+			if canLogin && !relogged {
+				relogged = true
+				if err = s.relogin(ctx, generation); err != nil {
+					return
+				}
+				attempt--
+				continue
+			}
+		}
+		if err == nil {
+			err = safeParse(parse, bytes.NewReader(body))
+		}
+		if IsTemporary(err) && attempt < s.Retry.MaxAttempts {
+			if err = sleep(ctx, s.Retry.delay(attempt)); err != nil {
+				return
+			}
+			continue
 		}
 
-		forum := Forum{
-			Title: node.Text(),
-			ID:    findIntAndParse(link),
+		if e, ok := err.(*Error); ok && len(e.URL) == 0 {
+			e.URL = s.baseURL() + path
+		} else if err != nil && !ok && err != ctx.Err() {
+			err = &Error{Kind: ErrParse, URL: s.baseURL() + path, Err: err}
 		}
-		forums = append(forums, forum)
+		return
 	}
-	return
 }
 
-// GetThreads returns threads in some forum at some page.
-func (s *S1Client) GetThreads(forum Forum, page int) (threads []Thread, err error) {
-	url := fmt.Sprintf(forumURLTemplate, forum.ID, page)
-
-	doc, err := s.getAndParase(url)
+// getAll reads a page relative to the base URL.
+func (s *S1Client) getAll(ctx context.Context, path string) ([]byte, error) {
+	body, err := s.get(ctx, path)
 	if err != nil {
-		return
+		return nil, err
+	}
+	defer body.Close()
+	content, err := ioutil.ReadAll(body)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	} else if err != nil {
+		return nil, &Error{Kind: ErrNetwork, URL: s.baseURL() + path, Err: err}
 	}
+	return content, nil
+}
 
-	nodes := doc.Find("ul[type] li")
-	for i := range nodes.Nodes {
-		node := nodes.Eq(i)
-		linkNode := node.Find("a")
+// safeParse turns panics of parse, e.g. from findIntAndParse, into errors.
+func safeParse(parse func(io.Reader) error, body io.Reader) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return parse(body)
+}
 
-		link, exists := linkNode.Attr("href")
-		if !exists {
-			err = errors.New("Cannot find thread link")
+// get fetches a page relative to the base URL.
+func (s *S1Client) get(ctx context.Context, path string) (io.ReadCloser, error) {
+	resp, err := s.request(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// request sends a request for a page relative to the base URL, posting form
+// if it isn't nil. Mirrors are tried in turn while the site is unreachable.
+func (s *S1Client) request(ctx context.Context, method, path string, form url.Values) (resp *http.Response, err error) {
+	for _, base := range s.bases() {
+		var body io.Reader
+		if form != nil {
+			body = strings.NewReader(form.Encode())
+		}
+		var req *http.Request
+		req, err = http.NewRequest(method, base+path, body)
+		if err != nil {
 			return
 		}
-
-		thread := Thread{
-			Forum: forum,
-			Title: linkNode.Text(),
-			ID:    findIntAndParse(link),
-			Reply: findIntAndParse(node.Nodes[0].LastChild.Data),
+		if form != nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		resp, err = s.do(req.WithContext(ctx))
+		if err == nil {
+			s.useBase(base)
+			return
+		}
+		if !unreachable(err) {
+			return
 		}
-		threads = append(threads, thread)
 	}
 	return
 }
 
-// GetPosts returns posts of some thread at some page.
-func (s *S1Client) GetPosts(thread Thread, page int) (posts []*Post, err error) {
-	url := fmt.Sprintf(threadURLTemplate, thread.ID, page)
-	doc, err := s.getAndParase(url)
-	if err != nil {
-		return
+// do sends req within the rate limit. Transport errors and responses other
+// than 2xx are returned as *Error, hosts answering 429 or 503 are also paused.
+// The error of the context of req is returned as is. The request counts as in
+// flight until the body of resp is closed.
+func (s *S1Client) do(req *http.Request) (resp *http.Response, err error) {
+	if len(s.UserAgent) > 0 {
+		req.Header.Set("User-Agent", s.UserAgent)
 	}
-
-	authorNodes := doc.Find(".author")
-	timePattern := regexp.MustCompile("\\d+-\\d+-\\d+ \\d+:\\d+")
-	authorPattern := regexp.MustCompile("<strong>(.*)</strong>")
-	tzshanghai, _ := time.LoadLocation("Asia/Shanghai")
-	for i := range authorNodes.Nodes {
-		node := authorNodes.Eq(i)
-		html, _ := node.Html()
-
-		postTimeStr := timePattern.FindString(html)
-		postTime, err := time.ParseInLocation("2006-1-2 15:04", postTimeStr, tzshanghai)
+	if s.Limiter != nil {
+		var release func()
+		release, err = s.Limiter.AcquireContext(req.Context(), req.URL.Host)
 		if err != nil {
-			return posts, err
+			return
 		}
-		author := authorPattern.FindStringSubmatch(html)[1]
-
-		post := &Post{
-			Author:   author,
-			Content:  getPostContent(node),
-			PostTime: postTime,
-			Thread:   thread,
+		resp, err = s.HttpClient.Do(req)
+		if err != nil {
+			release()
+		} else {
+			resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+			s.Limiter.Observe(req.URL.Host, resp)
+		}
+	} else {
+		resp, err = s.HttpClient.Do(req)
+	}
+	if err != nil {
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return nil, ctxErr
 		}
-		posts = append(posts, post)
+		return nil, &Error{Kind: ErrNetwork, URL: req.URL.String(), Err: err}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, statusError(req.URL.String(), resp)
 	}
-	return posts, nil
+	return
 }
 
-func (s *S1Client) getAndParase(url string) (doc *goquery.Document, err error) {
-	resp, err := s.HttpClient.Get(url)
-	if err != nil {
-		return
+// releasingBody is the body of a response that releases its slot of the rate
+// limiter when closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-	return goquery.NewDocumentFromResponse(resp)
 }
 
 func findIntAndParse(s string) (result int) {
@@ -205,22 +361,3 @@ func findIntAndParse(s string) (result int) {
 	result, _ = strconv.Atoi(intStr)
 	return
 }
-
-func getPostContent(author *goquery.Selection) (content string) {
-	next := author.NextAllFiltered(".author,.page").Eq(0)
-	buf := bytes.Buffer{}
-
-	node := author.Nodes[0].NextSibling
-	for ; node != nil && node != next.Nodes[0]; node = node.NextSibling {
-		if node.Type == html.TextNode {
-			buf.WriteString(node.Data)
-		}
-	}
-	content = buf.String()
-
-	// Remove \t s at begin/end of a line
-	content = regexp.MustCompile("(?m:(^\\t+)|(\\t+$))").ReplaceAllString(content, "")
-	// Remove new lines at begin/end of a post.
-	content = regexp.MustCompile("(^[\\r\\n]+)|([\\r\\n]+)$").ReplaceAllString(content, "")
-	return content
-}
@@ -1,23 +1,47 @@
 package client
 
 import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
 	"flag"
+	"github.com/PuerkitoBio/goquery"
 	"github.com/smy20011/s1go/test_util"
 	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 var (
-	username = flag.String("user", "", "username")
-	password = flag.String("pass", "", "password")
+	username      = flag.String("user", "", "username")
+	password      = flag.String("pass", "", "password")
+	fixtureServer = test_util.NewFixtureServer()
 )
 
+func TestMain(m *testing.M) {
+	code := m.Run()
+	fixtureServer.Close()
+	os.Exit(code)
+}
+
 func CreateMockS1Client() *S1Client {
+	return createTestClient(fixtureServer)
+}
+
+// createTestClient returns a client of the forum served by server.
+func createTestClient(server *httptest.Server) *S1Client {
 	return &S1Client{
-		HttpClient: &http.Client{
-			Transport: &test_util.MockS1Website{},
-		},
+		HttpClient: server.Client(),
+		BaseURL:    test_util.BaseURL(server),
 	}
 }
 
@@ -52,6 +76,11 @@ func TestGetPosts(t *testing.T) {
 	if len(posts) != 30 {
 		t.Errorf("Wrong Posts Count %v", len(posts))
 	}
+
+	// The archiver doesn't show floors.
+	posts, _ = client.GetPosts(Thread{ID: 1}, 2)
+	assert.Equal(t, 0, posts[0].Floor)
+	assert.Equal(t, "Meltina", posts[0].Author)
 }
 
 func TestGetSinglePost(t *testing.T) {
@@ -79,3 +108,658 @@ func TestLogin(t *testing.T) {
 		t.Fatalf("Expect login success but login failed: %v\n", err)
 	}
 }
+
+func TestGetPosts_richContent(t *testing.T) {
+	client := CreateMockS1Client()
+	posts, err := client.GetPosts(Thread{ID: 1}, 1)
+	assert.Nil(t, err)
+
+	// 10# quotes mihuye and replies below the quote.
+	blocks := posts[9].Blocks
+	assert.Equal(t, 2, len(blocks))
+	assert.Equal(t, QuoteBlock, blocks[0].Kind)
+	assert.Equal(t, "mihuye", blocks[0].QuoteAuthor)
+	assert.Equal(t, 1, len(blocks[0].Blocks))
+	assert.Equal(t, "求详细", blocks[1].Inlines[0].Text)
+}
+
+func TestParseContent(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div id="post">
+		<div class="quote"><blockquote><font size="2"><font color="#999999">Meltina 发表于 2016-7-21 21:14</font></font><br />
+		之前的帖子又没了</blockquote></div><br />
+		看<a href="https://example.com/" target="_blank">这里</a><img src="static/image/smiley/face2017/001.png" smilieid="1" alt="" /><br />
+		<img src="static/image/common/none.gif" zoomfile="data/attachment/forum/a.jpg" file="data/attachment/forum/a.jpg" />
+		<ignore_js_op><span><a href="forum.php?mod=attachment&amp;aid=MTIz">save.zip</a></span></ignore_js_op>
+	</div>`))
+	assert.Nil(t, err)
+	blocks := parseContent(doc.Find("#post").Nodes)
+
+	assert.Equal(t, 4, len(blocks))
+	assert.Equal(t, Block{
+		Kind:        QuoteBlock,
+		QuoteAuthor: "Meltina",
+		Blocks:      []Block{{Kind: ParagraphBlock, Inlines: []Inline{{Kind: TextInline, Text: "之前的帖子又没了"}}}},
+	}, blocks[0])
+	assert.Equal(t, []Inline{
+		{Kind: TextInline, Text: "看"},
+		{Kind: LinkInline, Text: "这里", URL: "https://example.com/"},
+		{Kind: SmileyInline, URL: "static/image/smiley/face2017/001.png"},
+	}, blocks[1].Inlines)
+	assert.Equal(t, []Inline{{Kind: ImageInline, URL: "data/attachment/forum/a.jpg"}}, blocks[2].Inlines)
+	assert.Equal(t, Block{Kind: AttachmentBlock, Name: "save.zip", URL: "forum.php?mod=attachment&aid=MTIz"}, blocks[3])
+}
+
+func CreateMockForumClient() *S1Client {
+	client := CreateMockS1Client()
+	client.Parser = ForumParser{}
+	return client
+}
+
+func TestForumParser_GetForums(t *testing.T) {
+	forums, err := CreateMockForumClient().GetForums()
+	assert.Nil(t, err)
+	assert.Equal(t, []Forum{
+		{Title: "外野", ID: 75},
+		{Title: "游戏论坛", ID: 4},
+		{Title: "动漫论坛", ID: 6},
+		{Title: "炉石传说", ID: 132},
+	}, forums)
+}
+
+func TestForumParser_GetThreads(t *testing.T) {
+	threads, err := CreateMockForumClient().GetThreads(Forum{ID: 4}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(threads))
+	assert.Equal(t, Thread{
+		Title:    "S1游戏区二手游戏交易贴",
+		ID:       1313554,
+		Reply:    1234,
+		Forum:    Forum{ID: 4},
+		Author:   "Meltina",
+		AuthorID: 32456,
+		Sticky:   true,
+	}, threads[0])
+	assert.False(t, threads[1].Sticky)
+	assert.Equal(t, 1500003, threads[2].ID)
+	assert.Equal(t, 88, threads[2].Reply)
+}
+
+func TestForumParser_GetPosts(t *testing.T) {
+	posts, err := CreateMockForumClient().GetPosts(Thread{ID: 1313554}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(posts))
+
+	first := posts[0]
+	assert.Equal(t, 32001001, first.ID)
+	assert.Equal(t, 1, first.Floor)
+	assert.Equal(t, "Meltina", first.Author)
+	assert.Equal(t, 32456, first.AuthorID)
+	assert.Equal(t, "2016-07-21 21:14", first.PostTime.Format("2006-01-02 15:04"))
+	assert.Equal(t, "Meltina", first.EditedBy)
+	assert.Equal(t, "2016-07-22 11:55", first.EditedAt.Format("2006-01-02 15:04"))
+	assert.Equal(t, "交易有风险，付款需谨慎", first.Signature)
+	assert.Equal(t, []Rating{
+		{User: "elxy", UserID: 1001, Score: 1, Reason: "好人一生平安"},
+		{User: "kara2000", UserID: 2002, Score: 1},
+	}, first.Ratings)
+	last := first.Blocks[len(first.Blocks)-1]
+	assert.Equal(t, ImageInline, last.Inlines[0].Kind)
+	assert.Equal(t, "https://img.saraba1st.com/forum/201607/21/211400aaa.jpg", last.Inlines[0].URL)
+
+	reply := posts[1]
+	assert.Equal(t, 2, reply.Floor)
+	assert.Equal(t, "2016-07-24 16:09:33", reply.PostTime.Format("2006-01-02 15:04:05"))
+	assert.Equal(t, QuoteBlock, reply.Blocks[0].Kind)
+	assert.Equal(t, "Meltina", reply.Blocks[0].QuoteAuthor)
+	assert.Equal(t, SmileyInline, reply.Blocks[1].Inlines[1].Kind)
+	assert.Equal(t, "Meltina:\n之前的帖子又没了，再开一个新的\n求一台新3DSLL，带猎人X的话最好", reply.Content)
+
+	attachment := posts[2].Blocks[1]
+	assert.Equal(t, AttachmentBlock, attachment.Kind)
+	assert.Equal(t, "3ds_list.zip", attachment.Name)
+}
+
+func TestNewParser(t *testing.T) {
+	parser, err := NewParser("forum")
+	assert.Nil(t, err)
+	assert.Equal(t, ForumParser{}, parser)
+	_, err = NewParser("wap")
+	assert.NotNil(t, err)
+}
+
+func TestAPIParser(t *testing.T) {
+	client := CreateMockS1Client()
+	client.Parser = APIParser{}
+
+	forums, err := client.GetForums()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(forums))
+	assert.Equal(t, Forum{Title: "炉石传说", ID: 132}, forums[3])
+
+	threads, err := client.GetThreads(forums[1], 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(threads))
+	assert.Equal(t, Thread{
+		Title:    "S1游戏区二手游戏交易贴",
+		ID:       1313554,
+		Reply:    1234,
+		Forum:    forums[1],
+		Author:   "Meltina",
+		AuthorID: 32456,
+		Sticky:   true,
+	}, threads[0])
+
+	posts, err := client.GetPosts(threads[0], 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(posts))
+	assert.Equal(t, 32001001, posts[0].ID)
+	assert.Equal(t, 1, posts[0].Floor)
+	assert.Equal(t, int64(1469106840), posts[0].PostTime.Unix())
+	assert.Equal(t, "Meltina", posts[0].EditedBy)
+	last := posts[0].Blocks[len(posts[0].Blocks)-1]
+	assert.Equal(t, "https://img.saraba1st.com/forum/201607/21/211400aaa.jpg", last.Inlines[0].URL)
+	assert.Equal(t, "Meltina", posts[1].Blocks[0].QuoteAuthor)
+	assert.Equal(t, 2002, posts[1].AuthorID)
+	assert.Equal(t, AttachmentBlock, posts[2].Blocks[1].Kind)
+	assert.Equal(t, "3ds_list.zip", posts[2].Blocks[1].Name)
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(1510387428, 0)
+	limiter := NewRateLimiter(RateLimit{Rate: 50, Burst: 1, HostRate: 10, HostBurst: 2})
+	limiter.now = func() time.Time { return now }
+
+	assert.Equal(t, time.Duration(0), limiter.reserve("a"))
+	assert.Equal(t, 20*time.Millisecond, limiter.reserve("b"))
+	// Host "a" still has a token, but the global bucket is in debt.
+	assert.Equal(t, 40*time.Millisecond, limiter.reserve("a"))
+	assert.Equal(t, 100*time.Millisecond, limiter.reserve("a"))
+
+	now = now.Add(time.Second)
+	assert.Equal(t, time.Duration(0), limiter.reserve("a"))
+}
+
+func TestRateLimiter_cancelled(t *testing.T) {
+	now := time.Unix(1510387428, 0)
+	limiter := NewRateLimiter(RateLimit{Rate: 1, Burst: 1, MaxConcurrent: 1})
+	limiter.now = func() time.Time { return now }
+	assert.Equal(t, time.Duration(0), limiter.reserve("a"))
+
+	// A request given up while waiting doesn't keep its token or slot.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := limiter.AcquireContext(ctx, "a")
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, time.Second, limiter.reserve("a"))
+	assert.Equal(t, 0, len(limiter.slots))
+}
+
+func TestS1Client_limiterSlot(t *testing.T) {
+	client := CreateMockS1Client()
+	client.Limiter = NewRateLimiter(RateLimit{MaxConcurrent: 1})
+	body, err := client.get(context.Background(), "forum.php")
+	assert.Nil(t, err)
+
+	// The slot is taken until the body is read and closed.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.Limiter.AcquireContext(ctx, "a")
+	assert.Equal(t, context.DeadlineExceeded, err)
+	ioutil.ReadAll(body)
+	body.Close()
+	body.Close()
+	release, err := client.Limiter.AcquireContext(context.Background(), "a")
+	assert.Nil(t, err)
+	release()
+	assert.Equal(t, 0, len(client.Limiter.slots))
+}
+
+func TestRateLimiter_backoff(t *testing.T) {
+	now := time.Unix(1510387428, 0)
+	limiter := NewRateLimiter(RateLimit{MaxBackoff: 3 * time.Second})
+	limiter.now = func() time.Time { return now }
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "30")
+	limiter.Observe("a", resp)
+	assert.Equal(t, 30*time.Second, limiter.reserve("a"))
+	assert.Equal(t, time.Duration(0), limiter.reserve("b"))
+
+	resp = &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	for _, expected := range []time.Duration{1, 2, 3, 3} {
+		limiter.Observe("b", resp)
+		assert.Equal(t, expected*time.Second, limiter.reserve("b"))
+		now = now.Add(expected * time.Second)
+	}
+	limiter.Observe("b", &http.Response{StatusCode: http.StatusOK})
+	assert.Equal(t, time.Duration(0), limiter.hosts["b"].backoff)
+}
+
+func TestS1Client_tooManyRequests(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	client := &S1Client{
+		HttpClient: server.Client(),
+		BaseURL:    test_util.BaseURL(server),
+		Limiter:    NewRateLimiter(RateLimit{}),
+	}
+	_, err := client.GetForums()
+	assert.NotNil(t, err)
+}
+
+func TestS1Client_retry(t *testing.T) {
+	failures := 2
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		(&test_util.MockS1Website{}).ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	client := &S1Client{
+		HttpClient: server.Client(),
+		BaseURL:    test_util.BaseURL(server),
+		Retry:      RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}
+	forums, err := client.GetForums()
+	assert.Nil(t, err)
+	assert.Equal(t, 16, len(forums))
+	assert.Equal(t, 0, failures)
+
+	failures = 3
+	_, err = client.GetForums()
+	assert.Equal(t, ErrHTTPStatus, Kind(err))
+	assert.True(t, IsTemporary(err))
+	assert.Equal(t, 0, failures)
+}
+
+func TestS1Client_errorKinds(t *testing.T) {
+	requests := 0
+	status := http.StatusForbidden
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte("<html><body><div id=\"postlist\"><div id=\"post_abc\"></div></div></body></html>"))
+	}))
+	defer server.Close()
+	client := &S1Client{
+		HttpClient: server.Client(),
+		BaseURL:    test_util.BaseURL(server),
+		Parser:     ForumParser{},
+		Retry:      RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}
+
+	_, err := client.GetPosts(Thread{ID: 1}, 1)
+	assert.Equal(t, ErrPermissionDenied, Kind(err))
+	assert.Equal(t, 1, requests)
+
+	status = http.StatusUnauthorized
+	_, err = client.GetPosts(Thread{ID: 1}, 1)
+	assert.Equal(t, ErrNotLoggedIn, Kind(err))
+
+	status = http.StatusOK
+	_, err = client.GetPosts(Thread{ID: 1}, 1)
+	assert.Equal(t, ErrParse, Kind(err))
+	assert.False(t, IsTemporary(err))
+
+	server.Close()
+	_, err = client.GetForums()
+	assert.Equal(t, ErrNetwork, Kind(err))
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for retry, max := range []time.Duration{1, 2, 4, 5, 5} {
+		delay := policy.delay(retry + 1)
+		assert.True(t, delay >= max*time.Second/2 && delay <= max*time.Second, "retry %d: %v", retry+1, delay)
+	}
+}
+
+func TestGetPosts_restricted(t *testing.T) {
+	for _, client := range []*S1Client{CreateMockS1Client(), CreateMockForumClient()} {
+		_, err := client.GetPosts(Thread{ID: test_util.RestrictedThread}, 1)
+		assert.Equal(t, ErrPermissionDenied, Kind(err))
+		assert.Contains(t, err.Error(), "无权访问该版块")
+
+		_, err = client.GetPosts(Thread{ID: test_util.LoginRequiredThread}, 1)
+		assert.Equal(t, ErrNotLoggedIn, Kind(err))
+	}
+}
+
+func TestAPIParser_restricted(t *testing.T) {
+	_, err := APIParser{}.ParsePosts(strings.NewReader(`{"Message":{"messageval":"viewperm_login_nopermission//1","messagestr":"您需要先登录"}}`), Thread{}, 1)
+	assert.Equal(t, ErrNotLoggedIn, Kind(err))
+	_, err = APIParser{}.ParsePosts(strings.NewReader(`{"Message":{"messageval":"viewperm_none_nopermission//1","messagestr":"无权访问"}}`), Thread{}, 1)
+	assert.Equal(t, ErrPermissionDenied, Kind(err))
+	_, err = APIParser{}.ParsePosts(strings.NewReader(`{"Message":{"messageval":"thread_nonexistence","messagestr":"主题不存在"}}`), Thread{}, 1)
+	assert.NotNil(t, err)
+	assert.Nil(t, Kind(err))
+}
+
+func createMockSessionClient(server *httptest.Server, sessionFile string) *S1Client {
+	jar, _ := cookiejar.New(nil)
+	client := createTestClient(server)
+	client.HttpClient = &http.Client{Transport: server.Client().Transport, Jar: jar}
+	client.SessionFile = sessionFile
+	return client
+}
+
+func TestS1Client_session(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "session")
+	defer os.RemoveAll(dir)
+	website := &test_util.MockS1Website{}
+	server := httptest.NewTLSServer(website)
+	defer server.Close()
+	client := createMockSessionClient(server, filepath.Join(dir, "session.json"))
+	assert.NotNil(t, client.Login("user", "WrongPassword"))
+	assert.Nil(t, client.Login("user", test_util.MockPassword))
+	assert.True(t, client.LoggedIn())
+	posts, err := client.GetPosts(Thread{ID: test_util.LoginRequiredThread}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 30, len(posts))
+
+	// A new client reuses the saved session instead of logging in again.
+	client = createMockSessionClient(server, client.SessionFile)
+	assert.Nil(t, client.LoadSession())
+	assert.True(t, client.LoggedIn())
+	assert.Nil(t, client.Login("user", test_util.MockPassword))
+	assert.Equal(t, 1, website.Logins)
+
+	// But not for a different user.
+	assert.Nil(t, client.Login("other", test_util.MockPassword))
+	assert.Equal(t, 2, website.Logins)
+
+	// The attributes of cookies are saved too, and expired ones aren't loaded.
+	data, _ := ioutil.ReadFile(client.SessionFile)
+	saved := session{}
+	assert.Nil(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, authCookie, saved.Cookies[0].Name)
+	assert.Equal(t, "/", saved.Cookies[0].Path)
+	saved.Cookies[0].Expires = time.Now().Add(-time.Hour)
+	data, _ = json.Marshal(saved)
+	ioutil.WriteFile(client.SessionFile, data, 0600)
+	client = createMockSessionClient(server, client.SessionFile)
+	assert.Nil(t, client.LoadSession())
+	assert.False(t, client.LoggedIn())
+}
+
+func TestS1Client_relogin(t *testing.T) {
+	website := &test_util.MockS1Website{}
+	server := httptest.NewTLSServer(website)
+	defer server.Close()
+	client := createMockSessionClient(server, "")
+	assert.Nil(t, client.Login("user", test_util.MockPassword))
+	u, _ := url.Parse(client.baseURL())
+
+	// The auth cookie expired.
+	client.HttpClient.Jar.SetCookies(u, []*http.Cookie{{Name: authCookie, Path: "/", MaxAge: -1}})
+	assert.False(t, client.LoggedIn())
+	_, err := client.GetPosts(Thread{ID: test_util.LoginRequiredThread}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, website.Logins)
+
+	// The site ended the session.
+	client.HttpClient.Jar.SetCookies(u, []*http.Cookie{{Name: authCookie, Value: "stale", Path: "/"}})
+	_, err = client.GetPosts(Thread{ID: test_util.LoginRequiredThread}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, website.Logins)
+}
+
+func TestS1Client_loginChallenges(t *testing.T) {
+	website := &test_util.MockS1Website{}
+	server := httptest.NewTLSServer(website)
+	defer server.Close()
+	client := createMockSessionClient(server, "")
+	err := client.Login("user", "WrongPassword")
+	assert.Equal(t, ErrLoginFailed, Kind(err))
+	assert.Contains(t, err.Error(), "登录失败")
+
+	err = client.Login(test_util.MockQuestionUser, test_util.MockPassword)
+	assert.Equal(t, ErrLoginFailed, Kind(err))
+	assert.Nil(t, client.LoginWith(Credentials{
+		Username:   test_util.MockQuestionUser,
+		Password:   test_util.MockPassword,
+		QuestionID: 1,
+		Answer:     test_util.MockAnswer,
+	}))
+	assert.True(t, client.LoggedIn())
+
+	website.Captcha = true
+	client = createMockSessionClient(server, "")
+	err = client.Login("user", test_util.MockPassword)
+	assert.Equal(t, ErrCaptchaRequired, Kind(err))
+
+	client.CaptchaSolver = func(captcha Captcha) (string, error) {
+		return "WXYZ", nil
+	}
+	err = client.Login("user", test_util.MockPassword)
+	assert.Equal(t, ErrLoginFailed, Kind(err))
+	assert.Contains(t, err.Error(), "验证码填写错误")
+
+	client.CaptchaSolver = func(captcha Captcha) (string, error) {
+		assert.Equal(t, test_util.MockCaptchaImage, string(captcha.Image))
+		return test_util.MockCaptcha, nil
+	}
+	assert.Nil(t, client.Login("user", test_util.MockPassword))
+	assert.Equal(t, 2, website.Logins)
+
+	// Logging in again when the session expires doesn't ask for captchas, we
+	// go on as a guest.
+	u, _ := url.Parse(client.baseURL())
+	client.HttpClient.Jar.SetCookies(u, []*http.Cookie{{Name: authCookie, Path: "/", MaxAge: -1}})
+	_, err = client.GetPosts(Thread{ID: test_util.LoginRequiredThread}, 1)
+	assert.Equal(t, ErrCaptchaRequired, Kind(err))
+	_, err = client.GetForums()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, website.Logins)
+}
+
+func TestS1Client_captchaElsewhere(t *testing.T) {
+	images := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, test_util.MockCaptchaImage)
+	}))
+	defer images.Close()
+	website := &test_util.MockS1Website{Captcha: true}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" || r.URL.Query().Get("mod") != "logging" {
+			website.ServeHTTP(w, r)
+			return
+		}
+		form := httptest.NewRecorder()
+		website.ServeHTTP(form, r)
+		io.WriteString(w, strings.Replace(form.Body.String(), `src="misc.php`, `src="`+images.URL+`/misc.php`, 1))
+	}))
+	defer server.Close()
+	client := createMockSessionClient(server, "")
+	client.CaptchaSolver = func(captcha Captcha) (string, error) {
+		assert.Equal(t, test_util.MockCaptchaImage, string(captcha.Image))
+		return test_util.MockCaptcha, nil
+	}
+	assert.Nil(t, client.Login("user", test_util.MockPassword))
+}
+
+func TestS1Client_loginFailed(t *testing.T) {
+	website := &test_util.MockS1Website{}
+	submits := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			submits++
+		}
+		website.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	client := createMockSessionClient(server, "")
+	assert.NotNil(t, client.Login("user", "WrongPassword"))
+
+	// We go on as a guest without posting the password again.
+	for i := 0; i < 2; i++ {
+		forums, err := client.GetForums()
+		assert.Nil(t, err)
+		assert.Equal(t, 16, len(forums))
+	}
+	_, err := client.GetPosts(Thread{ID: test_util.LoginRequiredThread}, 1)
+	assert.Equal(t, ErrLoginFailed, Kind(err))
+	assert.Equal(t, 1, submits)
+
+	// The password was changed while we were logged in.
+	assert.Nil(t, client.Login("user", test_util.MockPassword))
+	client.credentials.Password = "ChangedPassword"
+	u, _ := url.Parse(client.baseURL())
+	client.HttpClient.Jar.SetCookies(u, []*http.Cookie{{Name: authCookie, Path: "/", MaxAge: -1}})
+	for i := 0; i < 2; i++ {
+		_, err = client.GetForums()
+		assert.Nil(t, err)
+	}
+	_, err = client.GetPosts(Thread{ID: test_util.LoginRequiredThread}, 1)
+	assert.Equal(t, ErrLoginFailed, Kind(err))
+	assert.Equal(t, 3, submits)
+
+	// Until Login is called again.
+	assert.Nil(t, client.Login("user", test_util.MockPassword))
+	_, err = client.GetPosts(Thread{ID: test_util.LoginRequiredThread}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, submits)
+}
+
+func TestNewS1ClientWithOptions(t *testing.T) {
+	userAgents := []string{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.UserAgent())
+		(&test_util.MockS1Website{}).ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	// Certificates are verified by default.
+	client, err := NewS1ClientWithOptions(ClientOptions{BaseURL: server.URL + "/2b"})
+	assert.Nil(t, err)
+	assert.Equal(t, server.URL+"/2b/", client.BaseURL)
+	client.Retry = RetryPolicy{}
+	_, err = client.GetForums()
+	assert.Equal(t, ErrNetwork, Kind(err))
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	client, err = NewS1ClientWithOptions(ClientOptions{
+		BaseURL:   server.URL + "/2b/",
+		RootCAs:   pool,
+		UserAgent: "s1go-test",
+	})
+	assert.Nil(t, err)
+	forums, err := client.GetForums()
+	assert.Nil(t, err)
+	assert.Equal(t, 16, len(forums))
+	assert.Equal(t, "s1go-test", userAgents[len(userAgents)-1])
+
+	_, err = NewS1ClientWithOptions(ClientOptions{Proxy: "ftp://proxy.example"})
+	assert.NotNil(t, err)
+	_, err = NewS1ClientWithOptions(ClientOptions{BaseURL: "bbs.saraba1st.com/2b/"})
+	assert.NotNil(t, err)
+}
+
+func TestNewS1ClientWithOptions_proxy(t *testing.T) {
+	hosts := []string{}
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.URL.Host)
+		(&test_util.MockS1Website{}).ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+	client, err := NewS1ClientWithOptions(ClientOptions{
+		BaseURL: "http://s1.example/2b/",
+		Proxy:   proxy.URL,
+	})
+	assert.Nil(t, err)
+	forums, err := client.GetForums()
+	assert.Nil(t, err)
+	assert.Equal(t, 16, len(forums))
+	assert.Equal(t, []string{"s1.example"}, hosts)
+}
+
+func TestNewS1ClientWithOptions_timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+	client, _ := NewS1ClientWithOptions(ClientOptions{
+		BaseURL: server.URL,
+		Timeout: 20 * time.Millisecond,
+	})
+	client.Retry = RetryPolicy{}
+	_, err := client.GetForums()
+	assert.Equal(t, ErrNetwork, Kind(err))
+}
+
+func TestS1Client_mirrors(t *testing.T) {
+	down := httptest.NewTLSServer(http.NotFoundHandler())
+	down.Close()
+	badGateway := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer badGateway.Close()
+
+	client := CreateMockS1Client()
+	client.BaseURL = test_util.BaseURL(down)
+	client.Mirrors = []string{test_util.BaseURL(badGateway), test_util.BaseURL(fixtureServer)}
+	forums, err := client.GetForums()
+	assert.Nil(t, err)
+	assert.Equal(t, 16, len(forums))
+	// Following requests go to the mirror that answered.
+	assert.Equal(t, test_util.BaseURL(fixtureServer), client.baseURL())
+
+	client.Mirrors = []string{test_util.BaseURL(badGateway)}
+	_, err = client.GetForums()
+	assert.Equal(t, ErrHTTPStatus, Kind(err))
+
+	// Posting the login form fails over too.
+	website := &test_util.MockS1Website{}
+	readOnly := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		website.ServeHTTP(w, r)
+	}))
+	defer readOnly.Close()
+	client = createMockSessionClient(readOnly, "")
+	client.Mirrors = []string{test_util.BaseURL(fixtureServer)}
+	assert.Nil(t, client.Login("user", test_util.MockPassword))
+	assert.Equal(t, test_util.BaseURL(fixtureServer), client.baseURL())
+	// The session stays with the mirror that issued it.
+	client.useBase(test_util.BaseURL(readOnly))
+	assert.True(t, client.LoggedIn())
+}
+
+func TestS1Client_context(t *testing.T) {
+	started := make(chan bool, 1)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- true
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	client := createTestClient(server)
+	client.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	_, err := client.GetForumsContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	// Waiting for the rate limit is cancelled too.
+	client.Limiter = NewRateLimiter(RateLimit{Rate: 0.001})
+	u, _ := url.Parse(client.baseURL())
+	client.Limiter.reserve(u.Host)
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.GetPostsContext(ctx, Thread{ID: 1}, 1)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
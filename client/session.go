@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// session is what SaveSession writes to S1Client.SessionFile.
+type session struct {
+	Username string
+	// BaseURL is where the cookies were issued, the base URL of the client if
+	// empty.
+	BaseURL string
+	Cookies []sessionCookie
+}
+
+type sessionCookie struct {
+	Name   string
+	Value  string
+	Domain string
+	Path   string
+	// Expires is zero for cookies that only last the session.
+	Expires time.Time
+}
+
+// LoadSession restores the cookies saved in s.SessionFile, so Login doesn't
+// have to post the credentials again. Expired cookies are dropped. A missing
+// file is not an error.
+func (s *S1Client) LoadSession() error {
+	if len(s.SessionFile) == 0 {
+		return nil
+	}
+	file, err := os.Open(s.SessionFile)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	saved := session{}
+	if err := json.NewDecoder(file).Decode(&saved); err != nil {
+		return err
+	}
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+	now := time.Now()
+	cookies := []*http.Cookie{}
+	s.cookies = map[string]*http.Cookie{}
+	for _, saved := range saved.Cookies {
+		if !saved.Expires.IsZero() && !saved.Expires.After(now) {
+			continue
+		}
+		cookie := &http.Cookie{Name: saved.Name, Value: saved.Value, Domain: saved.Domain, Path: saved.Path, Expires: saved.Expires}
+		cookies = append(cookies, cookie)
+		s.cookies[cookie.Name] = cookie
+	}
+	s.setSessionBase(saved.BaseURL)
+	s.HttpClient.Jar.SetCookies(s.sessionURL(), cookies)
+	s.sessionUser = saved.Username
+	return nil
+}
+
+// SaveSession writes the cookies of the site to s.SessionFile, if set.
+func (s *S1Client) SaveSession() error {
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+	return s.saveSession()
+}
+
+// saveSession is SaveSession for callers holding loginMu.
+func (s *S1Client) saveSession() error {
+	if len(s.SessionFile) == 0 || s.HttpClient.Jar == nil {
+		return nil
+	}
+	saved := session{Username: s.credentials.Username, BaseURL: s.sessionURL().String()}
+	for _, cookie := range s.HttpClient.Jar.Cookies(s.sessionURL()) {
+		entry := sessionCookie{Name: cookie.Name, Value: cookie.Value}
+		if set := s.cookies[cookie.Name]; set != nil {
+			entry.Domain, entry.Path, entry.Expires = set.Domain, set.Path, set.Expires
+		}
+		saved.Cookies = append(saved.Cookies, entry)
+	}
+	file, err := os.OpenFile(s.SessionFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(file).Encode(saved); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// LoggedIn reports whether we have an unexpired auth cookie for the base URL it
+// was issued for. The site may still have ended the session, fetches notice
+// that and log in again.
+func (s *S1Client) LoggedIn() bool {
+	if s.HttpClient.Jar == nil {
+		return false
+	}
+	for _, cookie := range s.HttpClient.Jar.Cookies(s.sessionURL()) {
+		if cookie.Name == authCookie && len(cookie.Value) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// relogin logs in again with the credentials given to Login, unless another
+// goroutine has done so since generation of the session was observed, or
+// logging in has failed in this generation. Nobody may be there to solve a
+// captcha, so it fails with ErrCaptchaRequired.
+func (s *S1Client) relogin(ctx context.Context, generation int) error {
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+	if s.generation != generation {
+		return nil
+	} else if s.loginErr != nil {
+		return s.loginErr
+	}
+	return s.login(ctx, nil)
+}
+
+// sessionState returns the generation of the session and whether Login has
+// given us credentials to log in again that haven't failed.
+func (s *S1Client) sessionState() (generation int, canLogin bool) {
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+	return s.generation, len(s.credentials.Username) > 0 && s.loginErr == nil
+}
+
+// loginError returns why logging in failed in this generation, nil if it
+// didn't.
+func (s *S1Client) loginError() error {
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+	return s.loginErr
+}
+
+// sessionURL returns the base URL the session cookies belong to.
+func (s *S1Client) sessionURL() *url.URL {
+	s.mirrorMu.Lock()
+	base := s.sessionBase
+	s.mirrorMu.Unlock()
+	if len(base) == 0 {
+		base = s.baseURL()
+	}
+	u, _ := url.Parse(base)
+	return u
+}
+
+func (s *S1Client) setSessionBase(base string) {
+	s.mirrorMu.Lock()
+	s.sessionBase = base
+	s.mirrorMu.Unlock()
+}
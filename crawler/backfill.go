@@ -0,0 +1,194 @@
+package crawler
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+
+	"github.com/smy20011/s1go/client"
+	"github.com/smy20011/s1go/storage"
+)
+
+// backfillPriority makes backfill run only when the incremental crawl has
+// nothing to do.
+const backfillPriority = -1
+
+// Backfill archives every page of forums, which the incremental crawl doesn't
+// read past depth pages, and of threads. It continues where an interrupted
+// backfill stopped.
+func (c *Crawler) Backfill(forums, threads []int) error {
+	return c.BackfillContext(context.Background(), forums, threads)
+}
+
+// BackfillContext is Backfill that stops when ctx is done. Forums and threads
+// not finished yet are resumed by the next backfill.
+func (c *Crawler) BackfillContext(ctx context.Context, forums, threads []int) error {
+	ctx, finish, err := c.startWork(ctx)
+	if err != nil {
+		return err
+	}
+	defer finish()
+	forumPages := map[int]int{}
+	for _, id := range forums {
+		if forumPages[id], err = c.Storage.BackfillCursor(storage.ForumCursor(id)); err != nil {
+			return err
+		}
+	}
+	savedThreads := []client.Thread{}
+	for _, id := range threads {
+		savedThread, err := c.Storage.Get(id)
+		if err != nil {
+			return err
+		}
+		thread := client.Thread{
+			ID:       id,
+			Title:    savedThread.Title,
+			Forum:    client.Forum{ID: int(savedThread.ForumId)},
+			Author:   savedThread.Author,
+			AuthorID: int(savedThread.AuthorId),
+		}
+		if infos := savedThread.ThreadInfos; len(infos) > 0 {
+			thread.Reply = int(infos[len(infos)-1].Replies)
+		}
+		savedThreads = append(savedThreads, thread)
+	}
+
+	g := c.scheduler.Group()
+	for id, page := range forumPages {
+		if page == 0 {
+			page = 1
+		}
+		log.Printf("Start backfill forum %d from page %d\n", id, page)
+		c.backfillForumPage(ctx, g, client.Forum{ID: id}, page, nil)
+	}
+	for _, thread := range savedThreads {
+		c.backfillThread(ctx, g, thread, func() {})
+	}
+	g.Wait()
+	return ctx.Err()
+}
+
+// backfillForumPage queues backfilling page of forum. Once all threads on the
+// page are backfilled, the cursor of the forum moves to the next page, which
+// is queued unless it repeats last, the threads on the previous page.
+func (c *Crawler) backfillForumPage(ctx context.Context, g *Group, forum client.Forum, page int, last map[int]bool) {
+	cursor := storage.ForumCursor(forum.ID)
+	g.Submit(backfillPriority, func() {
+		if ctx.Err() != nil {
+			return
+		}
+		networkVar.Add("thread", 1)
+		logFetch()
+		threads, err := c.S1Client.GetThreadsContext(ctx, forum, page)
+		if err != nil {
+			recordFailure(err)
+			log.Printf("Error while backfill page %d of forum %d: %v\n", page, forum.ID, err)
+			return
+		}
+		ids := map[int]bool{}
+		repeated := true
+		for _, thread := range threads {
+			ids[thread.ID] = true
+			repeated = repeated && last[thread.ID]
+		}
+		// Pages past the last one repeat it.
+		if len(threads) == 0 || repeated {
+			log.Printf("Backfill of forum %d is done\n", forum.ID)
+			if err := c.Storage.ClearBackfillCursor(cursor); err != nil {
+				log.Printf("Cannot clear backfill cursor of forum %d: %v\n", forum.ID, err)
+			}
+			return
+		}
+		remaining := int32(len(threads))
+		next := func() {
+			if atomic.AddInt32(&remaining, -1) > 0 || ctx.Err() != nil {
+				return
+			}
+			if err := c.Storage.SetBackfillCursor(cursor, page+1); err != nil {
+				log.Printf("Cannot save backfill cursor of forum %d: %v\n", forum.ID, err)
+				return
+			}
+			c.backfillForumPage(ctx, g, forum, page+1, ids)
+		}
+		for _, thread := range threads {
+			c.backfillThread(ctx, g, thread, next)
+		}
+	})
+}
+
+// backfillThread queues backfilling thread from its cursor and calls done when
+// it's over.
+func (c *Crawler) backfillThread(ctx context.Context, g *Group, thread client.Thread, done func()) {
+	page, err := c.Storage.BackfillCursor(storage.ThreadCursor(thread.ID))
+	if err != nil {
+		log.Printf("Cannot read backfill cursor of thread %d: %v\n", thread.ID, err)
+		done()
+		return
+	}
+	if page == 0 {
+		page = 1
+	}
+	c.backfillPostPage(ctx, g, thread, page, nil, done)
+}
+
+// backfillPostPage queues storing the posts on page of thread, then the pages
+// after it. If the number of replies of thread is unknown, the last page is the
+// one not full of posts or followed by a repetition of it. last is the first
+// post of the previous page.
+func (c *Crawler) backfillPostPage(ctx context.Context, g *Group, thread client.Thread, page int, last *client.Post, done func()) {
+	cursor := storage.ThreadCursor(thread.ID)
+	pages := 0
+	if thread.Reply > 0 {
+		pages = thread.Reply/postPerPage + 1
+	}
+	g.Submit(backfillPriority, func() {
+		if ctx.Err() != nil {
+			return
+		}
+		networkVar.Add("post", 1)
+		logFetch()
+		posts, err := c.S1Client.GetPostsContext(ctx, thread, page)
+		if err != nil {
+			if err := c.finishVisit(thread, err); err != nil {
+				recordFailure(err)
+				log.Printf("Error while backfill page %d of thread %d: %v\n", page, thread.ID, err)
+			}
+			done()
+			return
+		}
+		if len(posts) > 0 && !(pages == 0 && last != nil && samePost(last, posts[0])) {
+			first := (page-1)*postPerPage + 1
+			if pages > 0 {
+				posts = postsUpTo(posts, first, thread.Reply+1)
+			}
+			keep := func(*client.Post) bool { return true }
+			if err := c.addPosts(thread, posts, first, keep); err != nil {
+				log.Printf("Error while backfill page %d of thread %d: %v\n", page, thread.ID, err)
+				done()
+				return
+			}
+			if pages > 0 && page < pages || pages == 0 && len(posts) >= postPerPage {
+				if err := c.Storage.SetBackfillCursor(cursor, page+1); err != nil {
+					log.Printf("Cannot save backfill cursor of thread %d: %v\n", thread.ID, err)
+					done()
+					return
+				}
+				c.backfillPostPage(ctx, g, thread, page+1, posts[0], done)
+				return
+			}
+		}
+		if err := c.Storage.ClearBackfillCursor(cursor); err != nil {
+			log.Printf("Cannot clear backfill cursor of thread %d: %v\n", thread.ID, err)
+		}
+		if err := c.finishVisit(thread, nil); err != nil {
+			log.Printf("Error while backfill thread %d: %v\n", thread.ID, err)
+		}
+		done()
+	})
+}
+
+// samePost reports whether a and b look like the same post, ignoring the
+// floor, which is made up from the page on some pages.
+func samePost(a, b *client.Post) bool {
+	return a.ID == b.ID && a.Author == b.Author && a.PostTime.Equal(b.PostTime) && a.Content == b.Content
+}
@@ -0,0 +1,45 @@
+package crawler
+
+import (
+	"time"
+
+	"github.com/smy20011/s1go/stage1stpb"
+)
+
+// compactions downsample the rank and reply history of threads, so it stays
+// bounded however long a thread is crawled. Visits older than age are kept
+// once per interval, the latest ones in each interval.
+var compactions = []struct {
+	age, interval time.Duration
+}{
+	{24 * time.Hour, time.Hour},
+	{7 * 24 * time.Hour, 24 * time.Hour},
+}
+
+// compactThreadInfos downsamples infos, which are ordered by time, as of now.
+func compactThreadInfos(infos []*stage1stpb.ThreadInfo, now time.Time) []*stage1stpb.ThreadInfo {
+	result := []*stage1stpb.ThreadInfo{}
+	for i, info := range infos {
+		if i+1 < len(infos) && sameInterval(info.Timestamp, infos[i+1].Timestamp, now) {
+			continue
+		}
+		result = append(result, info)
+	}
+	return result
+}
+
+// sameInterval reports whether the visits at unix times a and b fall into the
+// same interval of the compaction applying to a.
+func sameInterval(a, b int64, now time.Time) bool {
+	interval := time.Duration(0)
+	for _, compaction := range compactions {
+		if now.Sub(time.Unix(a, 0)) > compaction.age {
+			interval = compaction.interval
+		}
+	}
+	if interval == 0 {
+		return false
+	}
+	seconds := int64(interval / time.Second)
+	return a/seconds == b/seconds
+}
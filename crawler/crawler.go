@@ -1,9 +1,14 @@
 package crawler
 
 import (
+	"context"
 	"expvar"
 	"flag"
+	"fmt"
 	"log"
+	"math"
+	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,161 +18,743 @@ import (
 )
 
 var (
-	depth           = 3
-	postPerPage     = 30
-	maxThreadPage   = 3
-	maxThreadUpdate = 500
-	dbFile          = flag.String("db", "Stage1st.BoltDB", "Path to stage1st database.")
-	networkVar      = expvar.NewMap("crawler/network")
-	lastFetchVar    = expvar.NewInt("crawler/lastfetchtime")
+	depth         = flag.Int("depth", 3, "Pages of each forum crawled for new replies.")
+	postPerPage   = client.PostsPerPage
+	dbFile        = flag.String("db", "Stage1st.BoltDB", "Path to stage1st database.")
+	backend       = flag.String("backend", "bolt", "Storage of the database: bolt, sqlite (built with -tags sqlite) or memory.")
+	backup        = flag.Bool("backup", true, "Back up the database before migrating it to a new schema.")
+	sessionFile   = flag.String("session", "Stage1st.session", "Path to saved login session, empty to not save it.")
+	parserName    = flag.String("parser", "archiver", "Pages to crawl: archiver, forum or api.")
+	rate          = flag.Float64("rate", client.DefaultRateLimit.Rate, "Requests per second to Stage1st.")
+	burst         = flag.Int("burst", client.DefaultRateLimit.Burst, "Requests allowed in a burst.")
+	concurrency   = flag.Int("concurrency", client.DefaultRateLimit.MaxConcurrent, "Max requests in flight.")
+	baseURL       = flag.String("baseurl", client.DefaultBaseURL, "Root of the forum to crawl.")
+	mirrors       = flag.String("mirrors", "", "Comma separated roots of forum mirrors to use when -baseurl is unreachable.")
+	proxyURL      = flag.String("proxy", "", "http://, https:// or socks5:// proxy, HTTPS_PROXY if empty.")
+	caFile        = flag.String("ca", "", "PEM file of CA certificates to trust instead of the system ones.")
+	insecure      = flag.Bool("insecure", false, "Skip verification of TLS certificates.")
+	timeout       = flag.Duration("timeout", time.Minute, "Timeout of each request.")
+	userAgent     = flag.String("useragent", "", "User-Agent of requests.")
+	maxThreadPage = flag.Int("maxpages", 3, "Max pages of posts fetched per visit of a thread.")
+	verifyPages   = flag.Int("verifypages", 0, "Stored pages of posts fetched again per visit of a thread to track edits and deletions.")
+	minRefresh    = flag.Duration("minrefresh", 5*time.Minute, "Shortest time between visits of a thread.")
+	maxRefresh    = flag.Duration("maxrefresh", 24*time.Hour, "Longest time between visits of a thread.")
+	crawlWorkers  = flag.Int("workers", 4, "Number of forum pages, threads and pages of posts crawled at once.")
+	networkVar    = expvar.NewMap("crawler/network")
+	lastFetchVar  = expvar.NewInt("crawler/lastfetchtime")
+	failuresVar   = expvar.NewMap("crawler/failures")
 )
 
+// forumPagePriority makes forum pages go before threads, which are
+// prioritized by their replies since the last visit.
+const forumPagePriority = math.MaxInt32
+
 type Crawler struct {
 	S1Client *client.S1Client
-	Storage  *storage.Storage
+	Storage  storage.Store
+
+	mu     sync.Mutex
+	closed bool
+	// done is closed by Shutdown to cancel running crawls, and storageClosed
+	// once it has closed the storage.
+	done          chan struct{}
+	storageClosed chan struct{}
+	workers       sync.WaitGroup
+	server        *http.Server
+	scheduler     *Scheduler
+	// threadLocks serialize updates of the posts of a thread.
+	threadLocks [64]sync.Mutex
+}
+
+// New creates a crawler that stores threads fetched by s1Client in s.
+func New(s1Client *client.S1Client, s storage.Store) *Crawler {
+	return &Crawler{
+		S1Client:      s1Client,
+		Storage:       s,
+		done:          make(chan struct{}),
+		storageClosed: make(chan struct{}),
+		scheduler:     NewScheduler(*crawlWorkers),
+	}
 }
 
 func NewCrawler() (*Crawler, error) {
-	s, err := storage.Open(*dbFile)
+	parser, err := client.NewParser(*parserName)
 	if err != nil {
 		return nil, err
 	}
-	return &Crawler{
-		S1Client: client.NewS1Client(),
-		Storage:  &s,
-	}, nil
+	options := client.ClientOptions{
+		BaseURL:            *baseURL,
+		InsecureSkipVerify: *insecure,
+		Proxy:              *proxyURL,
+		Timeout:            *timeout,
+		UserAgent:          *userAgent,
+	}
+	if len(*mirrors) > 0 {
+		options.Mirrors = strings.Split(*mirrors, ",")
+	}
+	if len(*caFile) > 0 {
+		if options.RootCAs, err = client.LoadCertPool(*caFile); err != nil {
+			return nil, err
+		}
+	}
+	s1Client, err := client.NewS1ClientWithOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	limit := client.DefaultRateLimit
+	limit.Rate, limit.HostRate = *rate, *rate
+	limit.Burst, limit.HostBurst = *burst, *burst
+	limit.MaxConcurrent = *concurrency
+	s1Client.Parser = parser
+	s1Client.Limiter = client.NewRateLimiter(limit)
+	s1Client.SessionFile = *sessionFile
+	if err := s1Client.LoadSession(); err != nil {
+		log.Printf("Cannot load session %s: %v\n", *sessionFile, err)
+	}
+	s, err := openStore()
+	if err != nil {
+		return nil, err
+	}
+	return New(s1Client, s), nil
+}
+
+// openStore opens the database in the storage chosen by -backend.
+func openStore() (storage.Store, error) {
+	switch *backend {
+	case "", "bolt":
+		s, err := storage.OpenWithOptions(*dbFile, storage.Options{Backup: *backup})
+		if err != nil {
+			return nil, err
+		}
+		return &s, nil
+	case "sqlite":
+		s, err := storage.OpenSQLite(*dbFile)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case "memory":
+		return storage.NewMemoryStore(), nil
+	}
+	return nil, fmt.Errorf("Unknown backend %q", *backend)
+}
+
+// MigrateStorage migrates the database to the current schema, or only logs
+// the migrations it needs if dryRun is set.
+func MigrateStorage(dryRun bool) error {
+	if *backend != "" && *backend != "bolt" {
+		return fmt.Errorf("Backend %q has no migrations", *backend)
+	}
+	if !dryRun {
+		s, err := storage.OpenWithOptions(*dbFile, storage.Options{Backup: *backup})
+		if err != nil {
+			return err
+		}
+		s.Close()
+		return nil
+	}
+	pending, err := storage.PendingMigrations(*dbFile)
+	if err != nil {
+		return err
+	}
+	for _, migration := range pending {
+		log.Printf("Pending migration to version %d: %s\n", migration.Version, migration.Description)
+	}
+	if len(pending) == 0 {
+		log.Printf("Database is at schema version %d\n", storage.SchemaVersion())
+	}
+	return nil
+}
+
+// Login logs in and fetches threads that were restricted to logged in users
+// or higher user groups again.
+func (c *Crawler) Login(credentials client.Credentials) error {
+	return c.LoginContext(context.Background(), credentials)
 }
 
-func (c *Crawler) Login(username, password string) error {
-	return c.S1Client.Login(username, password)
+// LoginContext is Login that gives up when ctx is done.
+func (c *Crawler) LoginContext(ctx context.Context, credentials client.Credentials) error {
+	if err := c.S1Client.LoginWithContext(ctx, credentials); err != nil {
+		return err
+	}
+	return c.RetryRestrictedContext(ctx)
+}
+
+// RetryRestricted fetches posts of threads we weren't allowed to read before.
+func (c *Crawler) RetryRestricted() error {
+	return c.RetryRestrictedContext(context.Background())
+}
+
+// RetryRestrictedContext is RetryRestricted that stops when ctx is done.
+func (c *Crawler) RetryRestrictedContext(ctx context.Context) error {
+	ctx, finish, err := c.startWork(ctx)
+	if err != nil {
+		return err
+	}
+	defer finish()
+	restricted, err := c.Storage.Restricted()
+	if err != nil {
+		return err
+	}
+	ids := []int{}
+	for id := range restricted {
+		ids = append(ids, id)
+	}
+	return c.retryThreads(ctx, "restricted", ids)
+}
+
+// RetryFailed fetches posts of threads whose last visit failed.
+func (c *Crawler) RetryFailed() error {
+	return c.RetryFailedContext(context.Background())
+}
+
+// RetryFailedContext is RetryFailed that stops when ctx is done.
+func (c *Crawler) RetryFailedContext(ctx context.Context) error {
+	ctx, finish, err := c.startWork(ctx)
+	if err != nil {
+		return err
+	}
+	defer finish()
+	failures, err := c.Storage.Failures()
+	if err != nil {
+		return err
+	}
+	ids := []int{}
+	for id := range failures {
+		ids = append(ids, id)
+	}
+	return c.retryThreads(ctx, "failed", ids)
+}
+
+// retryThreads fetches posts of the stored threads ids, which are described
+// as what in logs.
+func (c *Crawler) retryThreads(ctx context.Context, what string, ids []int) error {
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		savedThread, err := c.Storage.Get(id)
+		if err != nil {
+			return err
+		}
+		thread := client.Thread{
+			ID:       id,
+			Title:    savedThread.Title,
+			Forum:    client.Forum{ID: int(savedThread.ForumId)},
+			Author:   savedThread.Author,
+			AuthorID: int(savedThread.AuthorId),
+			Sticky:   savedThread.Sticky,
+		}
+		if infos := savedThread.ThreadInfos; len(infos) > 0 {
+			thread.Reply = int(infos[len(infos)-1].Replies)
+		}
+		if err := c.fetchPosts(ctx, newThreadVisit(thread, savedThread)); err != nil {
+			recordFailure(err)
+			log.Printf("Error while fetch %s thread %s(%d): %v\n", what, thread.Title, thread.ID, err)
+		}
+	}
+	return nil
 }
 
 func (c *Crawler) FetchAllForums() error {
-	forums, err := c.S1Client.GetForums()
+	return c.FetchAllForumsContext(context.Background())
+}
+
+// FetchAllForumsContext is FetchAllForums that stops when ctx is done. Threads
+// being stored are finished first.
+func (c *Crawler) FetchAllForumsContext(ctx context.Context) error {
+	ctx, finish, err := c.startWork(ctx)
+	if err != nil {
+		return err
+	}
+	defer finish()
+	forums, err := c.S1Client.GetForumsContext(ctx)
 	networkVar.Add("forum", 1)
 	logFetch()
 	if err != nil {
 		return err
 	}
-	wg := sync.WaitGroup{}
+	g := c.scheduler.Group()
 	for _, forum := range forums {
-		f := forum
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			log.Printf("Start fetch forum %s(%d)\n", f.Title, f.ID)
-			err := c.fetchForum(f)
-			if err != nil {
-				log.Printf("Error while fetch forum %s: %v\n", f.Title, err)
-			}
-		}()
+		log.Printf("Start fetch forum %s(%d)\n", forum.Title, forum.ID)
+		c.submitForumPage(ctx, g, forum, 1, 0)
 	}
-	wg.Wait()
-	return nil
+	g.Wait()
+	return ctx.Err()
 }
 
+// Close shuts the crawler down without a deadline, see Shutdown.
 func (c *Crawler) Close() {
-	c.Storage.Close()
+	c.Shutdown(context.Background())
 }
 
-func (c *Crawler) fetchForum(forum client.Forum) (err error) {
-	threads := []client.Thread{}
-	for i := 0; i < depth; i++ {
+// submitForumPage queues fetching page of forum, whose first thread is ranked
+// rank. The task queues the threads on the page and the next page, up to
+// depth pages.
+func (c *Crawler) submitForumPage(ctx context.Context, g *Group, forum client.Forum, page, rank int) {
+	g.Submit(forumPagePriority, func() {
+		if ctx.Err() != nil {
+			return
+		}
 		networkVar.Add("thread", 1)
 		logFetch()
-		newThreads, err := c.S1Client.GetThreads(forum, i+1)
+		threads, err := c.S1Client.GetThreadsContext(ctx, forum, page)
 		if err != nil {
-			return err
+			recordFailure(err)
+			log.Printf("Error while fetch page %d of forum %s: %v\n", page, forum.Title, err)
+			return
 		}
-		threads = append(threads, newThreads...)
-	}
-	for index, thread := range threads {
-		err = c.fetchThread(index, thread)
+		if page < *depth && len(threads) > 0 {
+			c.submitForumPage(ctx, g, forum, page+1, rank+len(threads))
+		}
+		now := time.Now()
+		for index, thread := range threads {
+			replies := c.newReplies(thread)
+			if c.due(thread, replies, now) {
+				c.submitThread(ctx, g, rank+index, thread, replies)
+			}
+		}
+	})
+}
+
+// submitThread queues a visit of thread, which has replies new replies.
+// Threads with more new replies go first, their pages of posts are fetched one
+// after another.
+func (c *Crawler) submitThread(ctx context.Context, g *Group, index int, thread client.Thread, replies int) {
+	priority := replies
+	g.Submit(priority, func() {
+		if ctx.Err() != nil {
+			return
+		}
+		visit, err := c.startVisit(index, thread)
 		if err != nil {
-			log.Printf("Error while fetch thread %s(%d)\n", thread.Title, thread.ID)
+			recordFailure(err)
+			log.Printf("Error while fetch thread %s(%d): %v\n", thread.Title, thread.ID, err)
+		} else {
+			c.submitPostPages(ctx, g, priority+1, visit, visit.pages)
+		}
+	})
+}
+
+// submitPostPages queues fetching the first of pages, which queues the rest
+// once it is stored.
+func (c *Crawler) submitPostPages(ctx context.Context, g *Group, priority int, visit *threadVisit, pages []int) {
+	thread := visit.thread
+	if len(pages) == 0 {
+		if err := c.finishVisit(thread, nil); err != nil {
+			log.Printf("Error while fetch thread %s(%d): %v\n", thread.Title, thread.ID, err)
+		}
+		return
+	}
+	g.Submit(priority, func() {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := c.fetchPostPage(ctx, visit, pages[0]); err != nil {
+			if err := c.finishVisit(thread, err); err != nil {
+				recordFailure(err)
+				log.Printf("Error while fetch thread %s(%d): %v\n", thread.Title, thread.ID, err)
+			}
 			return
 		}
+		c.submitPostPages(ctx, g, priority, visit, pages[1:])
+	})
+}
+
+// newReplies returns the number of replies to thread since we last visited it.
+func (c *Crawler) newReplies(thread client.Thread) int {
+	info, err := c.Storage.LastSnapshot(thread.ID)
+	if err != nil || info == nil {
+		return thread.Reply + 1
 	}
-	return nil
+	replies := thread.Reply - int(info.Replies)
+	if replies < 0 {
+		return 0
+	}
+	return replies
 }
 
-func (c *Crawler) fetchThread(index int, thread client.Thread) error {
-	savedThread, err := c.Storage.Get(thread.ID)
+// fetchThread visits thread and fetches its new posts right away.
+func (c *Crawler) fetchThread(ctx context.Context, index int, thread client.Thread) error {
+	visit, err := c.startVisit(index, thread)
 	if err != nil {
 		return err
 	}
-	if savedThread.ThreadId != int32(thread.ID) {
-		log.Printf("New thread :%s\n", thread.Title)
-		savedThread = &stage1stpb.Thread{
-			ThreadId: int32(thread.ID),
-			ForumId:  int32(thread.Forum.ID),
-			Title:    thread.Title,
+	return c.fetchPosts(ctx, visit)
+}
+
+// threadVisit is a visit of a thread that fetches the posts after the ones
+// stored when it started.
+type threadVisit struct {
+	thread client.Thread
+	// fetched is the last floor stored before the visit that isn't past
+	// missing pages.
+	fetched int
+	// pages are the pages of posts to fetch, counting from 0.
+	pages []int
+}
+
+func newThreadVisit(thread client.Thread, savedThread *stage1stpb.Thread) *threadVisit {
+	visit := &threadVisit{thread: thread}
+	// Pages hold the posts that aren't deleted, so the stored posts not known
+	// to be deleted tell where the new posts are rather than floors. Floors
+	// further than a page apart mean the posts after them came from the last
+	// page, and the pages in between are still missing.
+	stored := 0
+	for index, post := range savedThread.Posts {
+		floor := storage.PostFloor(index, post)
+		if floor-visit.fetched > postPerPage {
+			break
+		}
+		visit.fetched = floor
+		if !post.Deleted {
+			stored++
 		}
 	}
-	// Skip thread update if we receive update for at least 100 times.
-	if len(savedThread.ThreadInfos) >= maxThreadUpdate {
-		return nil
+	// + 1 Because S1 the first post is not considered as reply
+	current := thread.Reply + 1
+	if stored >= current {
+		// Posts we haven't seen deleted pushed the new replies back over the
+		// stored ones, look for them on the last page.
+		stored = current - 1
 	}
+	visit.pages = getPagesToFetch(stored, current)
+	visit.pages = append(getPagesToVerify(stored, visit.pages), visit.pages...)
+	return visit
+}
 
-	savedThread.ThreadInfos = append(savedThread.ThreadInfos, &stage1stpb.ThreadInfo{
+// startVisit records the rank and replies of thread and stores it.
+func (c *Crawler) startVisit(index int, thread client.Thread) (*threadVisit, error) {
+	unlock := c.lockThread(thread.ID)
+	defer unlock()
+	savedThread, err := c.getThread(thread)
+	if err != nil {
+		return nil, err
+	}
+	savedThread.Sticky = thread.Sticky
+	now := time.Now()
+	savedThread.ThreadInfos = compactThreadInfos(append(savedThread.ThreadInfos, &stage1stpb.ThreadInfo{
 		Rank:      int32(index),
 		Replies:   int32(thread.Reply),
-		Timestamp: time.Now().Unix(),
+		Timestamp: now.Unix(),
+	}), now)
+	if err := c.Storage.Put(savedThread); err != nil {
+		return nil, err
+	}
+	next := now.Add(refreshInterval(savedThread.ThreadInfos))
+	if err := c.Storage.SetNextVisit(thread.ID, next); err != nil {
+		return nil, err
+	}
+	return newThreadVisit(thread, savedThread), nil
+}
+
+// getThread returns the stored thread, or a new one if thread has never been
+// stored.
+func (c *Crawler) getThread(thread client.Thread) (*stage1stpb.Thread, error) {
+	savedThread, err := c.Storage.Get(thread.ID)
+	if err != nil {
+		return nil, err
+	}
+	if savedThread.ThreadId != int32(thread.ID) {
+		log.Printf("New thread :%s\n", thread.Title)
+		savedThread = &stage1stpb.Thread{ThreadId: int32(thread.ID)}
+	}
+	// Threads backfilled by id don't know their title yet.
+	if len(savedThread.Title) == 0 {
+		savedThread.ForumId = int32(thread.Forum.ID)
+		savedThread.Title = thread.Title
+		savedThread.Author = thread.Author
+		savedThread.AuthorId = int32(thread.AuthorID)
+	}
+	return savedThread, nil
+}
+
+// lockThread locks the stored posts of thread threadId until the returned
+// function is called.
+func (c *Crawler) lockThread(threadId int) func() {
+	mu := &c.threadLocks[threadId%len(c.threadLocks)]
+	mu.Lock()
+	return mu.Unlock
+}
+
+// fetchPosts fetches the pages of visit in order.
+func (c *Crawler) fetchPosts(ctx context.Context, visit *threadVisit) error {
+	var err error
+	for _, page := range visit.pages {
+		if err = c.fetchPostPage(ctx, visit, page); err != nil {
+			break
+		}
+	}
+	return c.finishVisit(visit.thread, err)
+}
+
+// fetchPostPage stores the new posts on page of the visited thread.
+func (c *Crawler) fetchPostPage(ctx context.Context, visit *threadVisit, page int) error {
+	thread := visit.thread
+	// +1 Because S1 use 1 as the first page of thread.
+	networkVar.Add("post", 1)
+	logFetch()
+	posts, err := c.S1Client.GetPostsContext(ctx, thread, page+1)
+	if err != nil {
+		return err
+	}
+	first := page*postPerPage + 1
+	return c.addPosts(thread, postsUpTo(posts, first, thread.Reply+1), first, func(post *client.Post) bool {
+		return post.ID != 0 || post.Floor > visit.fetched
 	})
+}
 
-	posts, err := c.fetchNewPosts(thread, len(savedThread.Posts))
-	// Ignore post fetch error since it could failed due to lack of permission.
+// postsUpTo returns the posts of a page, whose first post is at position first,
+// up to position last. Pages with pids are returned whole, their posts can't be
+// mistaken for others.
+func postsUpTo(posts []*client.Post, first, last int) []*client.Post {
+	if len(posts) == 0 || posts[0].ID != 0 {
+		return posts
+	}
+	if n := last - first + 1; n < len(posts) {
+		if n < 0 {
+			n = 0
+		}
+		return posts[:n]
+	}
+	return posts
+}
+
+// addPosts stores the posts of thread that keep accepts and that aren't stored
+// yet. The posts are a page whose first post is at position first. Posts are
+// matched by pid when the page exposes it, otherwise by floor, placing posts
+// without one first. Edits and deletions of the stored posts on the page are
+// recorded as their revisions.
+func (c *Crawler) addPosts(thread client.Thread, posts []*client.Post, first int, keep func(*client.Post) bool) error {
+	unlock := c.lockThread(thread.ID)
+	defer unlock()
+	savedThread, err := c.getThread(thread)
 	if err != nil {
-		log.Printf("Fetch Thread failed %v", err)
+		return err
+	}
+	placePosts(savedThread.Posts, posts, first)
+	knownIds, knownFloors := map[int]*stage1stpb.Post{}, map[int]*stage1stpb.Post{}
+	for index, post := range savedThread.Posts {
+		if post.PostId != 0 {
+			knownIds[int(post.PostId)] = post
+		}
+		knownFloors[storage.PostFloor(index, post)] = post
 	}
+	now := time.Now()
+	changed, added := 0, 0
 	for _, post := range posts {
-		savedThread.Posts = append(savedThread.Posts, &stage1stpb.Post{
-			Author:   post.Author,
-			Content:  post.Content,
-			PostTime: post.PostTime.Unix(),
-		})
+		if post.ID != 0 {
+			if stored := knownIds[post.ID]; stored != nil {
+				if updatePost(stored, post, now) {
+					changed++
+				}
+				continue
+			}
+		} else if stored := knownFloors[post.Floor]; stored != nil {
+			// Estimated floors may be taken by another post, only compare the
+			// same post.
+			if stored.Author == post.Author && stored.PostTime == post.PostTime.Unix() && updatePost(stored, post, now) {
+				changed++
+			}
+			continue
+		}
+		if !keep(post) {
+			continue
+		}
+		stored := toProtoPost(post)
+		knownIds[post.ID], knownFloors[post.Floor] = stored, stored
+		savedThread.Posts = append(savedThread.Posts, stored)
+		added++
+	}
+	changed += markDeletedPosts(savedThread.Posts, posts, now)
+	if changed+added == 0 {
+		return nil
+	}
+	if added > 0 {
+		storage.SortPosts(savedThread.Posts)
 	}
-
 	return c.Storage.Put(savedThread)
 }
 
-func (c *Crawler) fetchNewPosts(thread client.Thread, fetched int) (posts []*client.Post, err error) {
-	// + 1 Because S1 the first post is not considered as reply
-	pages := getPagesToFetch(fetched, thread.Reply+1)
-	for _, page := range pages {
-		// +1 Because S1 use 1 as the first page of thread.
-		networkVar.Add("post", 1)
-		logFetch()
-		p, err := c.S1Client.GetPosts(thread, page+1)
-		if err != nil {
-			return posts, err
+// postIdentity tells apart posts without pids.
+type postIdentity struct {
+	author   string
+	postTime int64
+}
+
+// placePosts sets the floors of posts, a page whose first post is at position
+// first, that have neither pid nor floor like the posts of the archiver. A post
+// takes the floor of the stored post with the same author and post time.
+// Deleted posts drop out of the pages, so a post is never above its position
+// and new posts are placed after the post before them, past the stored floors.
+// The floors are estimates: posts deleted before we stored them leave no trace,
+// and posts of an author within the same minute are only told apart by order.
+func placePosts(stored []*stage1stpb.Post, posts []*client.Post, first int) {
+	floors, taken := map[postIdentity][]int{}, map[int]bool{}
+	for index, post := range stored {
+		floor := storage.PostFloor(index, post)
+		identity := postIdentity{post.Author, post.PostTime}
+		floors[identity] = append(floors[identity], floor)
+		taken[floor] = true
+	}
+	previous := 0
+	for i, post := range posts {
+		if post.ID != 0 || post.Floor != 0 {
+			previous = post.Floor
+			continue
+		}
+		floor := first + i
+		if floor <= previous {
+			floor = previous + 1
 		}
-		// Append new posts.
-		for index, post := range p {
-			postIndex := page*postPerPage + index
-			if postIndex >= fetched && postIndex <= thread.Reply {
-				posts = append(posts, post)
+		identity := postIdentity{post.Author, post.PostTime.Unix()}
+		candidates := floors[identity]
+		for len(candidates) > 0 && candidates[0] < floor {
+			candidates = candidates[1:]
+		}
+		if len(candidates) > 0 {
+			floor, floors[identity] = candidates[0], candidates[1:]
+		} else {
+			for taken[floor] {
+				floor++
 			}
+			taken[floor] = true
 		}
+		post.Floor, previous = floor, floor
+	}
+}
+
+// finishVisit records whether fetching posts of thread ended with err because
+// we aren't allowed to read the thread, or couldn't log in again to read it.
+// Such errors don't fail the visit, the thread is recorded as restricted
+// instead. Other errors are recorded as the failure of the thread until a
+// visit succeeds, unless the visit was cancelled.
+func (c *Crawler) finishVisit(thread client.Thread, err error) error {
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return err
+	}
+	switch kind := client.Kind(err); kind {
+	case nil:
+		if err != nil {
+			break
+		}
+		if err := c.Storage.ClearFailure(thread.ID); err != nil {
+			return err
+		}
+		return c.Storage.ClearRestricted(thread.ID)
+	case client.ErrPermissionDenied, client.ErrNotLoggedIn, client.ErrLoginFailed, client.ErrCaptchaRequired:
+		recordFailure(err)
+		log.Printf("Thread %s(%d) is restricted: %v\n", thread.Title, thread.ID, err)
+		if err := c.Storage.ClearFailure(thread.ID); err != nil {
+			return err
+		}
+		return c.Storage.MarkRestricted(thread.ID, kind.Error())
+	}
+	failure := storage.Failure{Kind: failureKind(err), Time: time.Now()}
+	if e := c.Storage.RecordFailure(thread.ID, failure); e != nil {
+		log.Printf("Cannot record failure of thread %s(%d): %v\n", thread.Title, thread.ID, e)
+	}
+	return err
+}
+
+func toProtoPost(post *client.Post) *stage1stpb.Post {
+	result := &stage1stpb.Post{
+		PostId:   int32(post.ID),
+		Floor:    int32(post.Floor),
+		Author:   post.Author,
+		AuthorId: int32(post.AuthorID),
+		Content:  post.Content,
+		PostTime: post.PostTime.Unix(),
+		RichContent: &stage1stpb.RichContent{
+			Blocks: toProtoBlocks(post.Blocks),
+		},
+		Signature: post.Signature,
+		EditedBy:  post.EditedBy,
+	}
+	if !post.EditedAt.IsZero() {
+		result.EditedAt = post.EditedAt.Unix()
+	}
+	for _, rating := range post.Ratings {
+		result.Ratings = append(result.Ratings, &stage1stpb.Rating{
+			User:   rating.User,
+			UserId: int32(rating.UserID),
+			Score:  int32(rating.Score),
+			Reason: rating.Reason,
+		})
+	}
+	return result
+}
+
+func toProtoBlocks(blocks []client.Block) (result []*stage1stpb.Block) {
+	for _, block := range blocks {
+		b := &stage1stpb.Block{
+			Kind:        stage1stpb.Block_Kind(block.Kind),
+			QuoteAuthor: block.QuoteAuthor,
+			Blocks:      toProtoBlocks(block.Blocks),
+			Url:         block.URL,
+			Name:        block.Name,
+		}
+		for _, inline := range block.Inlines {
+			b.Inlines = append(b.Inlines, &stage1stpb.Inline{
+				Kind: stage1stpb.Inline_Kind(inline.Kind),
+				Text: inline.Text,
+				Url:  inline.URL,
+			})
+		}
+		result = append(result, b)
 	}
 	return
 }
 
+// getPagesToFetch returns the pages, counting from 0, holding the posts after
+// floor fetched up to floor current, at most maxThreadPage of them. The last
+// page is always fetched for the newest posts, the others from the oldest.
+// The rest are left to the next visits.
 func getPagesToFetch(fetched, current int) (result []int) {
-	// Skip fetch when not enough threads.
-	if current-fetched < postPerPage/2 && fetched != 0 {
+	if current <= fetched {
 		return
 	}
-	for i := 0; i < current/postPerPage+1 && i < maxThreadPage; i++ {
-		postStart := i * postPerPage
-		postEnd := i*postPerPage + postPerPage
-		if postStart >= fetched || postEnd > fetched {
+	last := (current - 1) / postPerPage
+	for i := fetched / postPerPage; i < last && len(result) < *maxThreadPage-1; i++ {
+		result = append(result, i)
+	}
+	return append(result, last)
+}
+
+// getPagesToVerify returns the last verifyPages pages holding posts up to
+// floor fetched that aren't in pages, to notice edits and deletions.
+func getPagesToVerify(fetched int, pages []int) (result []int) {
+	if fetched == 0 {
+		return
+	}
+	last := (fetched - 1) / postPerPage
+	if len(pages) > 0 && pages[0] <= last {
+		last = pages[0] - 1
+	}
+	for i := last - *verifyPages + 1; i <= last; i++ {
+		if i >= 0 {
 			result = append(result, i)
 		}
 	}
 	return
 }
 
+// recordFailure counts err by its kind in the crawler/failures expvar.
+func recordFailure(err error) {
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return
+	}
+	failuresVar.Add(failureKind(err), 1)
+}
+
+// failureKind returns the kind of err, "other" if it isn't an S1Client error.
+func failureKind(err error) string {
+	if kind := client.Kind(err); kind != nil {
+		return kind.Error()
+	}
+	return "other"
+}
+
 func logFetch() {
 	lastFetchVar.Set(time.Now().Unix())
 }
@@ -1,23 +1,39 @@
 package crawler
 
 import (
+	"context"
 	"fmt"
 	"github.com/smy20011/s1go/client"
+	"github.com/smy20011/s1go/stage1stpb"
 	"github.com/smy20011/s1go/storage"
 	"github.com/smy20011/s1go/test_util"
+	"github.com/smy20011/s1go/test_util/data"
 	"github.com/stretchr/testify/assert"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+	"unicode"
 )
 
+var fixtureServer = test_util.NewFixtureServer()
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	fixtureServer.Close()
+	os.Exit(code)
+}
+
 func CreateMockS1Client() *client.S1Client {
 	return &client.S1Client{
-		HttpClient: &http.Client{
-			Transport: &test_util.MockS1Website{},
-		},
+		HttpClient: fixtureServer.Client(),
+		BaseURL:    test_util.BaseURL(fixtureServer),
 	}
 }
 
@@ -42,7 +58,7 @@ func CreateTestFixture() *TestFixture {
 	if err != nil {
 		panic(err)
 	}
-	f.crawler = &Crawler{CreateMockS1Client(), &s}
+	f.crawler = New(CreateMockS1Client(), &s)
 	return &f
 }
 
@@ -54,7 +70,7 @@ func (f *TestFixture) Cleanup() {
 func TestCrawler_fetchThread(t *testing.T) {
 	f := CreateTestFixture()
 	defer f.Cleanup()
-	f.crawler.fetchThread(12345, client.Thread{ID: 12345, Reply: 20})
+	f.crawler.fetchThread(context.Background(), 12345, client.Thread{ID: 12345, Reply: 20})
 	thread, err := f.crawler.Storage.Get(12345)
 	if err != nil {
 		t.Fatal(err)
@@ -62,12 +78,13 @@ func TestCrawler_fetchThread(t *testing.T) {
 	assert.Equal(t, int32(12345), thread.ThreadId)
 	assert.Equal(t, 21, len(thread.Posts))
 	assert.Equal(t, 1, len(thread.ThreadInfos))
+	assert.NotEmpty(t, thread.Posts[0].RichContent.Blocks)
 }
 
 func TestCrawler_fetchThread_singleThread(t *testing.T) {
 	f := CreateTestFixture()
 	defer f.Cleanup()
-	f.crawler.fetchThread(1, client.Thread{
+	f.crawler.fetchThread(context.Background(), 1, client.Thread{
 		ID:    test_util.SinglePostThread,
 		Reply: 0,
 	})
@@ -80,42 +97,147 @@ func TestCrawler_fetchThread_singleThread(t *testing.T) {
 	assert.Equal(t, 1, len(thread.ThreadInfos))
 }
 
+// newPagedServer serves thread.html as every page of thread 12345, dated in
+// year 2000 + page, so that the pages hold different posts like in a real
+// thread.
+func newPagedServer() *httptest.Server {
+	website := &test_util.MockS1Website{}
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if !strings.Contains(r.URL.Path, "tid-12345") || err != nil {
+			website.ServeHTTP(w, r)
+			return
+		}
+		html := strings.Replace(string(data.MustAsset("data/thread.html")), "2016-", fmt.Sprintf("%d-", 2000+page), -1)
+		io.WriteString(w, html)
+	}))
+}
+
 func TestCrawler_fetchThread_maxPost(t *testing.T) {
 	f := CreateTestFixture()
 	defer f.Cleanup()
-	f.crawler.fetchThread(12345, client.Thread{ID: 12345, Reply: 2000})
-	f.crawler.fetchThread(12345, client.Thread{ID: 12345, Reply: 2000})
-	f.crawler.fetchThread(12345, client.Thread{ID: 12345, Reply: 2000})
+	server := newPagedServer()
+	defer server.Close()
+	f.crawler.S1Client = &client.S1Client{HttpClient: server.Client(), BaseURL: test_util.BaseURL(server)}
+	f.crawler.fetchThread(context.Background(), 12345, client.Thread{ID: 12345, Reply: 2000})
+	f.crawler.fetchThread(context.Background(), 12345, client.Thread{ID: 12345, Reply: 2000})
+	f.crawler.fetchThread(context.Background(), 12345, client.Thread{ID: 12345, Reply: 2000})
 	thread, _ := f.crawler.Storage.Get(12345)
 	assert.Equal(t, int32(12345), thread.ThreadId)
-	assert.Equal(t, 90, len(thread.Posts))
+	// Each visit fetches the last page and continues with the oldest missing
+	// pages.
+	assert.Equal(t, 201, len(thread.Posts))
+	assert.Equal(t, int32(180), thread.Posts[179].Floor)
+	assert.Equal(t, int32(1981), thread.Posts[180].Floor)
+	assert.Equal(t, int32(2001), thread.Posts[200].Floor)
 	assert.Equal(t, 3, len(thread.ThreadInfos))
 }
 
+func TestCrawler_fetchThread_longThread(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	server := newPagedServer()
+	defer server.Close()
+	f.crawler.S1Client = &client.S1Client{HttpClient: server.Client(), BaseURL: test_util.BaseURL(server)}
+	// A long thread stored up to its 3rd page gets new replies deep down.
+	f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: 12345, Reply: 89})
+	f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: 12345, Reply: 1510})
+	thread, _ := f.crawler.Storage.Get(12345)
+	// The newest replies are stored right away, after the oldest missing pages.
+	assert.Equal(t, 90+60+11, len(thread.Posts))
+	assert.Equal(t, int32(150), thread.Posts[149].Floor)
+	assert.Equal(t, int32(1501), thread.Posts[150].Floor)
+	assert.Equal(t, int32(1511), thread.Posts[160].Floor)
+
+	// The next visit stores the replies since, and goes on filling the gap.
+	f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: 12345, Reply: 1520})
+	thread, _ = f.crawler.Storage.Get(12345)
+	assert.Equal(t, 150+60+21, len(thread.Posts))
+	assert.Equal(t, int32(210), thread.Posts[209].Floor)
+	assert.Equal(t, int32(1501), thread.Posts[210].Floor)
+	assert.Equal(t, int32(1521), thread.Posts[230].Floor)
+}
+
+func TestGetPagesToFetch(t *testing.T) {
+	assert.Equal(t, []int{0}, getPagesToFetch(0, 1))
+	assert.Equal(t, []int{0, 1}, getPagesToFetch(0, 31))
+	assert.Empty(t, getPagesToFetch(31, 31))
+	assert.Equal(t, []int{1}, getPagesToFetch(31, 40))
+	// Only the pages after the stored posts, however deep.
+	assert.Equal(t, []int{66}, getPagesToFetch(1990, 2001))
+	// The last page first, then the oldest missing pages.
+	assert.Equal(t, []int{3, 4, 66}, getPagesToFetch(90, 2001))
+	assert.Equal(t, []int{65, 66}, getPagesToFetch(1960, 2001))
+}
+
 func TestCrawler_fetchThread_newPosts(t *testing.T) {
 	f := CreateTestFixture()
 	defer f.Cleanup()
-	f.crawler.fetchThread(12345, client.Thread{ID: 12345, Reply: 30})
+	f.crawler.fetchThread(context.Background(), 12345, client.Thread{ID: 12345, Reply: 30})
 	thread, _ := f.crawler.Storage.Get(12345)
 	assert.Equal(t, 31, len(thread.Posts))
 
-	f.crawler.fetchThread(12345, client.Thread{ID: 12345, Reply: 70})
+	f.crawler.fetchThread(context.Background(), 12345, client.Thread{ID: 12345, Reply: 70})
 	thread, _ = f.crawler.Storage.Get(12345)
 	assert.Equal(t, 71, len(thread.Posts))
 	assert.Equal(t, 2, len(thread.ThreadInfos))
 }
 
-func TestCrawler_fetchThread_newPosts_notEnoughPost(t *testing.T) {
+func TestCrawler_fetchThread_deletedPost(t *testing.T) {
 	f := CreateTestFixture()
 	defer f.Cleanup()
-	f.crawler.fetchThread(12345, client.Thread{ID: 12345, Reply: 30})
+	f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: 12345, Reply: 29})
+	saved, _ := f.crawler.Storage.Get(12345)
+	saved.ThreadId = test_util.DeletedPostThread
+	f.crawler.Storage.Put(saved)
+
+	// The 5th post was deleted and a reply was posted, so the number of replies
+	// didn't change.
+	f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: test_util.DeletedPostThread, Reply: 29})
+	thread, _ := f.crawler.Storage.Get(test_util.DeletedPostThread)
+	assert.Equal(t, 31, len(thread.Posts))
+	assert.Equal(t, "kara2000", thread.Posts[4].Author)
+	assert.True(t, thread.Posts[4].Deleted)
+	assert.True(t, thread.Posts[4].Revisions[0].Deleted)
+	// The posts after it moved up a position but kept their floors.
+	assert.Equal(t, int32(6), thread.Posts[5].Floor)
+	assert.Empty(t, thread.Posts[5].Revisions)
+	assert.Equal(t, int32(30), thread.Posts[29].Floor)
+	assert.Equal(t, int32(31), thread.Posts[30].Floor)
+	assert.Equal(t, "Meltina", thread.Posts[30].Author)
+	assert.Contains(t, thread.Posts[30].Content, "新的回复")
+}
+
+func TestCrawler_fetchThread_forumParser(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	f.crawler.S1Client.Parser = client.ForumParser{}
+	f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: 1313554, Reply: 2, Author: "Meltina", AuthorID: 32456, Sticky: true})
+	thread, _ := f.crawler.Storage.Get(1313554)
+	assert.Equal(t, "Meltina", thread.Author)
+	assert.True(t, thread.Sticky)
+	assert.Equal(t, 3, len(thread.Posts))
+	assert.Equal(t, int32(32001001), thread.Posts[0].PostId)
+	assert.Equal(t, 2, len(thread.Posts[0].Ratings))
+	assert.NotZero(t, thread.Posts[0].EditedAt)
+
+	// Posts are matched by pid, so revisiting the thread adds nothing.
+	f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: 1313554, Reply: 40})
+	thread, _ = f.crawler.Storage.Get(1313554)
+	assert.Equal(t, 3, len(thread.Posts))
+}
+
+func TestCrawler_fetchThread_newPosts_fewPosts(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	f.crawler.fetchThread(context.Background(), 12345, client.Thread{ID: 12345, Reply: 30})
 	thread, _ := f.crawler.Storage.Get(12345)
 	assert.Equal(t, 1, len(thread.ThreadInfos))
 	assert.Equal(t, 31, len(thread.Posts))
 
-	f.crawler.fetchThread(12345, client.Thread{ID: 12345, Reply: 40})
+	f.crawler.fetchThread(context.Background(), 12345, client.Thread{ID: 12345, Reply: 40})
 	thread, _ = f.crawler.Storage.Get(12345)
-	assert.Equal(t, 31, len(thread.Posts))
+	assert.Equal(t, 41, len(thread.Posts))
 	assert.Equal(t, 2, len(thread.ThreadInfos))
 }
 
@@ -123,8 +245,8 @@ func TestCrawler_FetchAllForums(t *testing.T) {
 	t.SkipNow()
 	f := CreateTestFixture()
 	defer f.Cleanup()
-	maxThreadPage = 1
-	depth = 1
+	*maxThreadPage = 1
+	*depth = 1
 	f.crawler.FetchAllForums()
 }
 
@@ -134,9 +256,392 @@ func TestCrawler_StorageSize(t *testing.T) {
 	defer f.Cleanup()
 
 	for i := 1; i < 500; i++ {
-		f.crawler.fetchThread(i, client.Thread{ID: i, Reply: 100})
+		f.crawler.fetchThread(context.Background(), i, client.Thread{ID: i, Reply: 100})
 	}
 	info, _ := os.Stat(f.file)
 	f.crawler.Storage.Close()
 	fmt.Printf("Storage Size for 500 threads: %v MB\n", float32(info.Size())/1024.0/1024.0)
 }
+
+func TestCrawler_fetchThread_restricted(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	err := f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: test_util.RestrictedThread, Reply: 10})
+	assert.Nil(t, err)
+	f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: test_util.LoginRequiredThread, Reply: 10})
+	thread, _ := f.crawler.Storage.Get(test_util.RestrictedThread)
+	assert.Equal(t, 1, len(thread.ThreadInfos))
+	assert.Empty(t, thread.Posts)
+	restricted, _ := f.crawler.Storage.Restricted()
+	assert.Equal(t, map[int]string{
+		test_util.RestrictedThread:    client.ErrPermissionDenied.Error(),
+		test_util.LoginRequiredThread: client.ErrNotLoggedIn.Error(),
+	}, restricted)
+
+	// Pretend thread 12345 became readable after login.
+	f.crawler.Storage.Put(&stage1stpb.Thread{
+		ThreadId:    12345,
+		ThreadInfos: []*stage1stpb.ThreadInfo{{Replies: 20}},
+	})
+	f.crawler.Storage.MarkRestricted(12345, client.ErrNotLoggedIn.Error())
+	assert.Nil(t, f.crawler.RetryRestricted())
+	thread, _ = f.crawler.Storage.Get(12345)
+	assert.Equal(t, 21, len(thread.Posts))
+	assert.Equal(t, 1, len(thread.ThreadInfos))
+	restricted, _ = f.crawler.Storage.Restricted()
+	assert.Equal(t, 2, len(restricted))
+	assert.NotContains(t, restricted, 12345)
+
+	// Logging in again failed.
+	f.crawler.S1Client.Login("user", "WrongPassword")
+	assert.Nil(t, f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: test_util.LoginRequiredThread, Reply: 10}))
+	restricted, _ = f.crawler.Storage.Restricted()
+	assert.Equal(t, client.ErrLoginFailed.Error(), restricted[test_util.LoginRequiredThread])
+}
+
+func TestCrawler_fetchThread_failed(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	assert.NotNil(t, f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: test_util.MissingThread, Reply: 10}))
+	failures, _ := f.crawler.Storage.Failures()
+	assert.Equal(t, 1, len(failures))
+	assert.Equal(t, client.ErrHTTPStatus.Error(), failures[test_util.MissingThread].Kind)
+	assert.False(t, failures[test_util.MissingThread].Time.IsZero())
+	restricted, _ := f.crawler.Storage.Restricted()
+	assert.Empty(t, restricted)
+
+	// Pretend thread 12345 failed before and can be fetched now.
+	f.crawler.Storage.Put(&stage1stpb.Thread{
+		ThreadId:    12345,
+		ThreadInfos: []*stage1stpb.ThreadInfo{{Replies: 20}},
+	})
+	f.crawler.Storage.RecordFailure(12345, storage.Failure{Kind: client.ErrNetwork.Error(), Time: time.Now()})
+	assert.Nil(t, f.crawler.RetryFailed())
+	thread, _ := f.crawler.Storage.Get(12345)
+	assert.Equal(t, 21, len(thread.Posts))
+	failures, _ = f.crawler.Storage.Failures()
+	assert.Equal(t, 1, len(failures))
+	assert.NotContains(t, failures, 12345)
+}
+
+func TestCrawler_FetchAllForumsContext_cancelled(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, f.crawler.FetchAllForumsContext(ctx))
+	assert.Equal(t, context.Canceled, f.crawler.fetchThread(ctx, 0, client.Thread{ID: 12345, Reply: 20}))
+	thread, _ := f.crawler.Storage.Get(12345)
+	assert.Empty(t, thread.Posts)
+}
+
+func TestCrawler_Shutdown(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	started := make(chan bool, 1)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- true
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	f.crawler.S1Client.HttpClient = server.Client()
+	f.crawler.S1Client.BaseURL = test_util.BaseURL(server)
+
+	result := make(chan error)
+	go func() {
+		result <- f.crawler.FetchAllForums()
+	}()
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.Nil(t, f.crawler.Shutdown(ctx))
+	assert.Equal(t, context.Canceled, <-result)
+	assert.Equal(t, ErrShutdown, f.crawler.FetchAllForums())
+}
+
+func TestCrawler_Shutdown_timeout(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	_, finish, err := f.crawler.startWork(context.Background())
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, f.crawler.Shutdown(ctx))
+	// The crawl that didn't stop can still store what it fetched.
+	assert.Nil(t, f.crawler.Storage.Put(&stage1stpb.Thread{ThreadId: 1}))
+	select {
+	case <-f.crawler.StorageClosed():
+		t.Fatal("Storage closed before the crawl is over")
+	default:
+	}
+	finish()
+	select {
+	case <-f.crawler.StorageClosed():
+	case <-time.After(time.Second):
+		t.Fatal("Storage not closed after the crawl is over")
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	s := NewScheduler(1)
+	defer s.Close()
+	started, release := make(chan bool), make(chan bool)
+	s.Submit(0, func() {
+		started <- true
+		<-release
+	})
+	<-started
+	order := []int{}
+	for _, priority := range []int{1, 3, 2, 3} {
+		p := priority
+		s.Submit(p, func() {
+			order = append(order, p)
+			if p == 2 {
+				s.Submit(4, func() { order = append(order, 4) })
+			}
+		})
+	}
+	close(release)
+	s.Wait()
+	assert.Equal(t, []int{3, 3, 2, 4, 1}, order)
+}
+
+func TestCrawler_submitForumPage(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	oldDepth, oldMaxThreadPage := *depth, *maxThreadPage
+	*depth, *maxThreadPage = 1, 1
+	defer func() {
+		*depth, *maxThreadPage = oldDepth, oldMaxThreadPage
+	}()
+	g := f.crawler.scheduler.Group()
+	f.crawler.submitForumPage(context.Background(), g, client.Forum{ID: 6}, 1, 0)
+	g.Wait()
+	for _, id := range []int{793032, 1313554, 1313524} {
+		thread, _ := f.crawler.Storage.Get(id)
+		assert.Equal(t, int32(id), thread.ThreadId)
+		assert.Equal(t, 1, len(thread.ThreadInfos))
+		assert.NotEmpty(t, thread.Posts)
+	}
+}
+
+func TestRefreshInterval(t *testing.T) {
+	info := func(replies int32, minutes int64) *stage1stpb.ThreadInfo {
+		return &stage1stpb.ThreadInfo{Replies: replies, Timestamp: minutes * 60}
+	}
+	assert.Equal(t, *minRefresh, refreshInterval([]*stage1stpb.ThreadInfo{info(10, 0)}))
+	// 15 replies per hour.
+	assert.Equal(t, time.Hour, refreshInterval([]*stage1stpb.ThreadInfo{info(10, 0), info(25, 60)}))
+	// 15 replies per minute.
+	assert.Equal(t, *minRefresh, refreshInterval([]*stage1stpb.ThreadInfo{info(10, 0), info(25, 1)}))
+	assert.Equal(t, *maxRefresh, refreshInterval([]*stage1stpb.ThreadInfo{info(10, 0), info(10, 60)}))
+
+	infos := []*stage1stpb.ThreadInfo{info(0, 0)}
+	for i := int64(1); i <= 10; i++ {
+		infos = append(infos, info(1000+int32(i)*15, 1000+i*60))
+	}
+	// Only the latest visits count.
+	assert.Equal(t, time.Hour, refreshInterval(infos))
+}
+
+func TestCrawler_due(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	now := time.Now()
+	thread := client.Thread{ID: 12345, Reply: 20}
+	assert.True(t, f.crawler.due(thread, 21, now))
+
+	f.crawler.fetchThread(context.Background(), 0, thread)
+	next, _ := f.crawler.Storage.NextVisit(12345)
+	assert.True(t, next.After(now))
+	assert.False(t, f.crawler.due(thread, 5, now))
+	assert.True(t, f.crawler.due(thread, 5, next))
+	assert.True(t, f.crawler.due(thread, postPerPage, now))
+}
+
+func TestCrawler_Backfill(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	f.crawler.Storage.Put(&stage1stpb.Thread{
+		ThreadId:    12345,
+		ThreadInfos: []*stage1stpb.ThreadInfo{{Replies: 100}},
+	})
+	assert.Nil(t, f.crawler.Backfill(nil, []int{12345, 54321}))
+	thread, _ := f.crawler.Storage.Get(12345)
+	assert.Equal(t, 101, len(thread.Posts))
+	assert.Equal(t, int32(101), thread.Posts[100].Floor)
+	// The fixture repeats the first page of posts forever.
+	thread, _ = f.crawler.Storage.Get(54321)
+	assert.Equal(t, int32(54321), thread.ThreadId)
+	assert.Equal(t, 30, len(thread.Posts))
+	page, _ := f.crawler.Storage.BackfillCursor(storage.ThreadCursor(12345))
+	assert.Equal(t, 0, page)
+}
+
+func TestCrawler_Backfill_resume(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	f.crawler.Storage.Put(&stage1stpb.Thread{
+		ThreadId:    12345,
+		ThreadInfos: []*stage1stpb.ThreadInfo{{Replies: 100}},
+	})
+	f.crawler.Storage.SetBackfillCursor(storage.ThreadCursor(12345), 3)
+	assert.Nil(t, f.crawler.Backfill(nil, []int{12345}))
+	thread, _ := f.crawler.Storage.Get(12345)
+	assert.Equal(t, 41, len(thread.Posts))
+	assert.Equal(t, int32(61), thread.Posts[0].Floor)
+}
+
+func TestCrawler_Backfill_search(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	// Later floors first, then the earlier ones are inserted before them.
+	later := &stage1stpb.Thread{
+		ThreadId:    12345,
+		ThreadInfos: []*stage1stpb.ThreadInfo{{Replies: 29}},
+	}
+	for floor := 61; floor <= 70; floor++ {
+		later.Posts = append(later.Posts, &stage1stpb.Post{Floor: int32(floor), Author: "later", PostTime: int64(floor)})
+	}
+	f.crawler.Storage.Put(later)
+	assert.Nil(t, f.crawler.Backfill(nil, []int{12345}))
+	thread, _ := f.crawler.Storage.Get(12345)
+	assert.Equal(t, 40, len(thread.Posts))
+	assert.Equal(t, int32(61), thread.Posts[30].Floor)
+
+	query := ""
+	for _, post := range thread.Posts {
+		if query = hanBigram(post.Content); len(query) > 0 {
+			break
+		}
+	}
+	expected := 0
+	for _, post := range thread.Posts {
+		if strings.Contains(post.Content, query) {
+			expected++
+		}
+	}
+	results, err := f.crawler.Storage.Search(query, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, len(results))
+	for _, result := range results {
+		post := thread.Posts[result.PostIndex]
+		assert.Equal(t, int32(result.Floor), post.Floor)
+		assert.Contains(t, post.Content, query)
+	}
+}
+
+// hanBigram returns the first two consecutive Chinese characters of text.
+func hanBigram(text string) string {
+	runes := []rune(text)
+	for i := 0; i+1 < len(runes); i++ {
+		if unicode.Is(unicode.Han, runes[i]) && unicode.Is(unicode.Han, runes[i+1]) {
+			return string(runes[i : i+2])
+		}
+	}
+	return ""
+}
+
+func TestCompactThreadInfos(t *testing.T) {
+	day := time.Unix(1500000000/86400*86400, 0)
+	now := day.Add(10 * 24 * time.Hour)
+	infos := []*stage1stpb.ThreadInfo{}
+	for _, at := range []time.Time{
+		// Daily after a week.
+		day.Add(time.Hour), day.Add(4 * time.Hour),
+		// Hourly after a day.
+		now.Add(-48*time.Hour + 10*time.Minute), now.Add(-48*time.Hour + 40*time.Minute),
+		now.Add(-47*time.Hour + 10*time.Minute),
+		// Every visit of the last day.
+		now.Add(-time.Hour), now.Add(-50 * time.Minute), now,
+	} {
+		infos = append(infos, &stage1stpb.ThreadInfo{Timestamp: at.Unix()})
+	}
+	compacted := compactThreadInfos(infos, now)
+	assert.Equal(t, []*stage1stpb.ThreadInfo{infos[1], infos[3], infos[4], infos[5], infos[6], infos[7]}, compacted)
+	assert.Equal(t, compacted, compactThreadInfos(compacted, now))
+}
+
+func TestCrawler_fetchThread_manyVisits(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	saved := &stage1stpb.Thread{ThreadId: 12345}
+	start := time.Now().Add(-30 * 24 * time.Hour).Unix()
+	for i := int64(0); i < 1000; i++ {
+		saved.ThreadInfos = append(saved.ThreadInfos, &stage1stpb.ThreadInfo{Timestamp: start + i*600})
+	}
+	f.crawler.Storage.Put(saved)
+	f.crawler.fetchThread(context.Background(), 0, client.Thread{ID: 12345, Reply: 20})
+	thread, _ := f.crawler.Storage.Get(12345)
+	assert.Equal(t, 21, len(thread.Posts))
+	assert.True(t, len(thread.ThreadInfos) < 10)
+}
+
+func TestCrawler_addPosts_revisions(t *testing.T) {
+	f := CreateTestFixture()
+	defer f.Cleanup()
+	thread := client.Thread{ID: 12345}
+	keep := func(*client.Post) bool { return true }
+	page := []*client.Post{
+		{ID: 1, Floor: 1, Content: "first"},
+		{ID: 2, Floor: 2, Content: "second"},
+		{ID: 3, Floor: 3, Content: "third"},
+	}
+	f.crawler.addPosts(thread, page, 1, keep)
+
+	edited := time.Unix(1500000000, 0)
+	f.crawler.addPosts(thread, []*client.Post{
+		{ID: 1, Floor: 1, Content: "first"},
+		{ID: 3, Floor: 2, Content: "third\nedited", EditedBy: "author", EditedAt: edited},
+	}, 1, keep)
+	saved, _ := f.crawler.Storage.Get(12345)
+	assert.Equal(t, 3, len(saved.Posts))
+	assert.Empty(t, saved.Posts[0].Revisions)
+	assert.True(t, saved.Posts[1].Deleted)
+	assert.Equal(t, "second", saved.Posts[1].Content)
+	assert.True(t, saved.Posts[1].Revisions[0].Deleted)
+	assert.Equal(t, "third\nedited", saved.Posts[2].Content)
+	assert.Equal(t, edited.Unix(), saved.Posts[2].EditedAt)
+	assert.Equal(t, 1, len(saved.Posts[2].Revisions))
+	assert.Equal(t, "third", saved.Posts[2].Revisions[0].Content)
+	assert.Equal(t, contentHash("third"), saved.Posts[2].Revisions[0].ContentHash)
+	assert.Equal(t, []string{" third", "+edited"}, revisionChanges(saved.Posts[2])[0].Diff)
+
+	// Seeing the same page again changes nothing.
+	f.crawler.addPosts(thread, page[:1], 1, keep)
+	saved, _ = f.crawler.Storage.Get(12345)
+	assert.Equal(t, 1, len(saved.Posts[1].Revisions))
+
+	// The same content laid out by another parser is not a revision.
+	f.crawler.addPosts(thread, []*client.Post{
+		{ID: 1, Floor: 1, Content: " first\r\n\n"},
+		{ID: 3, Floor: 2, Content: "third\n\n  edited", EditedBy: "author", EditedAt: edited},
+	}, 1, keep)
+	saved, _ = f.crawler.Storage.Get(12345)
+	assert.Empty(t, saved.Posts[0].Revisions)
+	assert.Equal(t, " first\r\n\n", saved.Posts[0].Content)
+	assert.Equal(t, 1, len(saved.Posts[2].Revisions))
+	assert.Equal(t, "third\n\n  edited", saved.Posts[2].Content)
+}
+
+func TestNormalizeContent(t *testing.T) {
+	assert.Equal(t, "a b\nc", normalizeContent("  a \t b\r\n\n\u00a0c \n"))
+}
+
+func TestDiffLines(t *testing.T) {
+	assert.Nil(t, diffLines("a\nb", "a\nb"))
+	assert.Equal(t, []string{" a", "-b", "+c", " d"}, diffLines("a\nb\nd", "a\nc\nd"))
+	assert.Equal(t, []string{"-a", " b", "+c"}, diffLines("a\nb", "b\nc"))
+}
+
+func TestGetPagesToVerify(t *testing.T) {
+	old := *verifyPages
+	defer func() { *verifyPages = old }()
+	assert.Empty(t, getPagesToVerify(90, []int{3}))
+	*verifyPages = 2
+	assert.Empty(t, getPagesToVerify(0, []int{0}))
+	assert.Equal(t, []int{1, 2}, getPagesToVerify(90, nil))
+	assert.Equal(t, []int{1, 2}, getPagesToVerify(90, []int{3}))
+	// The page of the last stored post is fetched anyway.
+	assert.Equal(t, []int{0, 1}, getPagesToVerify(70, []int{2}))
+}
@@ -0,0 +1,57 @@
+package crawler
+
+import (
+	"log"
+	"time"
+
+	"github.com/smy20011/s1go/client"
+	"github.com/smy20011/s1go/stage1stpb"
+)
+
+// rateWindow is the number of latest visits the reply rate of a thread is
+// measured over.
+var rateWindow = 10
+
+// refreshInterval returns how long to wait before visiting a thread again, so
+// that about half a page of replies is new by then at the reply rate seen over
+// its latest visits.
+func refreshInterval(infos []*stage1stpb.ThreadInfo) time.Duration {
+	if len(infos) < 2 {
+		return *minRefresh
+	}
+	first, last := infos[0], infos[len(infos)-1]
+	if len(infos) > rateWindow {
+		first = infos[len(infos)-rateWindow]
+	}
+	elapsed := time.Duration(last.Timestamp-first.Timestamp) * time.Second
+	replies := last.Replies - first.Replies
+	if elapsed <= 0 {
+		return *minRefresh
+	}
+	if replies <= 0 {
+		return *maxRefresh
+	}
+	interval := elapsed / time.Duration(replies) * time.Duration(postPerPage/2)
+	if interval < *minRefresh {
+		return *minRefresh
+	}
+	if interval > *maxRefresh {
+		return *maxRefresh
+	}
+	return interval
+}
+
+// due reports whether thread, which has replies new replies, should be visited
+// at now. Threads are visited early when their forum page shows a page of new
+// replies.
+func (c *Crawler) due(thread client.Thread, replies int, now time.Time) bool {
+	if replies >= postPerPage {
+		return true
+	}
+	next, err := c.Storage.NextVisit(thread.ID)
+	if err != nil {
+		log.Printf("Cannot read next visit of thread %s(%d): %v\n", thread.Title, thread.ID, err)
+		return true
+	}
+	return !now.Before(next)
+}
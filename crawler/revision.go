@@ -0,0 +1,184 @@
+package crawler
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/smy20011/s1go/client"
+	"github.com/smy20011/s1go/stage1stpb"
+	"github.com/smy20011/s1go/storage"
+)
+
+// updatePost records an edit of stored, a post seen again as post at now, in
+// the revisions of stored. It reports whether stored has changed. Contents
+// are compared normalized, since each parser lays out the same post
+// differently; a post that only changed layout is updated without a
+// revision.
+func updatePost(stored *stage1stpb.Post, post *client.Post, now time.Time) bool {
+	restored := stored.Deleted
+	// Restored by moderators.
+	stored.Deleted = false
+	if stored.Content == post.Content {
+		return restored
+	}
+	if normalizeContent(stored.Content) != normalizeContent(post.Content) {
+		stored.Revisions = append(stored.Revisions, newRevision(stored, now))
+	}
+	updated := toProtoPost(post)
+	stored.Content = updated.Content
+	stored.RichContent = updated.RichContent
+	stored.Signature = updated.Signature
+	stored.EditedBy = updated.EditedBy
+	stored.EditedAt = updated.EditedAt
+	stored.Ratings = updated.Ratings
+	return true
+}
+
+// normalizeContent returns content with each line trimmed, runs of
+// whitespace collapsed into a single space and blank lines dropped.
+func normalizeContent(content string) string {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markDeleted records that stored was deleted at now.
+func markDeleted(stored *stage1stpb.Post, now time.Time) {
+	revision := newRevision(stored, now)
+	revision.Deleted = true
+	stored.Revisions = append(stored.Revisions, revision)
+	stored.Deleted = true
+}
+
+// markDeletedPosts marks the stored posts missing from posts, a page of
+// posts, as deleted. Posts are told apart by pid, or by the floor placePosts
+// gave them on pages without pids. Only posts between the first and the last
+// post of the page are known to be missing. It returns the number of posts
+// marked.
+func markDeletedPosts(stored []*stage1stpb.Post, posts []*client.Post, now time.Time) (deleted int) {
+	byPid := len(posts) > 0 && posts[0].ID != 0
+	first, last := 0, 0
+	seen := map[int]bool{}
+	for _, post := range posts {
+		key := post.Floor
+		if byPid {
+			key = post.ID
+		}
+		if key == 0 {
+			return
+		}
+		seen[key] = true
+		if first == 0 || key < first {
+			first = key
+		}
+		if key > last {
+			last = key
+		}
+	}
+	for index, post := range stored {
+		key := storage.PostFloor(index, post)
+		if byPid {
+			key = int(post.PostId)
+		}
+		if key > first && key < last && !seen[key] && !post.Deleted {
+			markDeleted(post, now)
+			deleted++
+		}
+	}
+	return
+}
+
+func newRevision(post *stage1stpb.Post, now time.Time) *stage1stpb.Revision {
+	return &stage1stpb.Revision{
+		Content:     post.Content,
+		ContentHash: contentHash(post.Content),
+		EditedBy:    post.EditedBy,
+		EditedAt:    post.EditedAt,
+		ReplacedAt:  now.Unix(),
+	}
+}
+
+func contentHash(content string) string {
+	hash := sha1.Sum([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// revisionChange is how a revision of a post was replaced, as served by the
+// query server.
+type revisionChange struct {
+	ContentHash string   `json:"content_hash"`
+	EditedBy    string   `json:"edited_by,omitempty"`
+	EditedAt    int64    `json:"edited_at,omitempty"`
+	ReplacedAt  int64    `json:"replaced_at"`
+	Deleted     bool     `json:"deleted,omitempty"`
+	Diff        []string `json:"diff,omitempty"`
+}
+
+// revisionChanges returns the changes from each revision of post to the next
+// one, or to the current content for the latest revision.
+func revisionChanges(post *stage1stpb.Post) (changes []revisionChange) {
+	for i, revision := range post.Revisions {
+		next := post.Content
+		if i+1 < len(post.Revisions) {
+			next = post.Revisions[i+1].Content
+		}
+		changes = append(changes, revisionChange{
+			ContentHash: revision.ContentHash,
+			EditedBy:    revision.EditedBy,
+			EditedAt:    revision.EditedAt,
+			ReplacedAt:  revision.ReplacedAt,
+			Deleted:     revision.Deleted,
+			Diff:        diffLines(revision.Content, next),
+		})
+	}
+	return
+}
+
+// diffLines returns the lines of a and b prefixed by "-" if they were removed
+// from a, "+" if they were added in b and " " if they are in both, or nil if
+// a and b are equal.
+func diffLines(a, b string) (diff []string) {
+	if a == b {
+		return nil
+	}
+	x, y := strings.Split(a, "\n"), strings.Split(b, "\n")
+	// common[i][j] is the length of the longest common subsequence of x[i:]
+	// and y[j:].
+	common := make([][]int, len(x)+1)
+	for i := range common {
+		common[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else if common[i+1][j] >= common[i][j+1] {
+				common[i][j] = common[i+1][j]
+			} else {
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			diff = append(diff, " "+x[i])
+			i++
+			j++
+		case j == len(y) || i < len(x) && common[i+1][j] >= common[i][j+1]:
+			diff = append(diff, "-"+x[i])
+			i++
+		default:
+			diff = append(diff, "+"+y[j])
+			j++
+		}
+	}
+	return
+}
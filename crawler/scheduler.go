@@ -0,0 +1,139 @@
+package crawler
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// Scheduler runs tasks on a fixed number of workers, higher priority first
+// and in submission order among equal priorities. Tasks may submit more
+// tasks.
+type Scheduler struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	queue   taskQueue
+	seq     int
+	closed  bool
+	pending sync.WaitGroup
+	workers sync.WaitGroup
+}
+
+type task struct {
+	priority int
+	seq      int
+	run      func()
+}
+
+// NewScheduler starts a scheduler with workers workers, at least one.
+func NewScheduler(workers int) *Scheduler {
+	if workers < 1 {
+		workers = 1
+	}
+	s := &Scheduler{}
+	s.cond = sync.NewCond(&s.mu)
+	for i := 0; i < workers; i++ {
+		s.workers.Add(1)
+		go s.work()
+	}
+	return s
+}
+
+// Submit queues run with priority.
+func (s *Scheduler) Submit(priority int, run func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending.Add(1)
+	s.seq++
+	heap.Push(&s.queue, &task{priority: priority, seq: s.seq, run: run})
+	s.cond.Signal()
+}
+
+// Wait blocks until every submitted task, including the ones submitted by
+// tasks, has run.
+func (s *Scheduler) Wait() {
+	s.pending.Wait()
+}
+
+// Close stops the workers once the queue is empty and waits for them.
+func (s *Scheduler) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.cond.Broadcast()
+	s.mu.Unlock()
+	s.workers.Wait()
+}
+
+// Group is a set of tasks run by a Scheduler that can be waited for apart
+// from the other tasks.
+type Group struct {
+	s  *Scheduler
+	wg sync.WaitGroup
+}
+
+// Group returns an empty group of tasks run by s.
+func (s *Scheduler) Group() *Group {
+	return &Group{s: s}
+}
+
+// Submit queues run in the group with priority.
+func (g *Group) Submit(priority int, run func()) {
+	g.wg.Add(1)
+	g.s.Submit(priority, func() {
+		defer g.wg.Done()
+		run()
+	})
+}
+
+// Wait blocks until every task of the group, including the ones submitted to
+// it by its tasks, has run.
+func (g *Group) Wait() {
+	g.wg.Wait()
+}
+
+func (s *Scheduler) work() {
+	defer s.workers.Done()
+	for {
+		s.mu.Lock()
+		for len(s.queue) == 0 && !s.closed {
+			s.cond.Wait()
+		}
+		if len(s.queue) == 0 {
+			s.mu.Unlock()
+			return
+		}
+		t := heap.Pop(&s.queue).(*task)
+		s.mu.Unlock()
+
+		t.run()
+		s.pending.Done()
+	}
+}
+
+// taskQueue is a heap of tasks.
+type taskQueue []*task
+
+func (q taskQueue) Len() int {
+	return len(q)
+}
+
+func (q taskQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q taskQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *taskQueue) Push(x interface{}) {
+	*q = append(*q, x.(*task))
+}
+
+func (q *taskQueue) Pop() interface{} {
+	old := *q
+	t := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return t
+}
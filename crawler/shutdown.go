@@ -0,0 +1,101 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"log"
+)
+
+// ErrShutdown is returned by crawls started after Shutdown.
+var ErrShutdown = errors.New("crawler is shut down")
+
+// startWork registers a crawl that Shutdown waits for. It returns a context
+// that is cancelled when ctx is done or Shutdown is called, and a function to
+// call once the crawl is over.
+func (c *Crawler) startWork(ctx context.Context) (context.Context, func(), error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, nil, ErrShutdown
+	}
+	c.workers.Add(1)
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-c.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, func() {
+		cancel()
+		c.workers.Done()
+	}, nil
+}
+
+// Shutdown stops new crawls, cancels the requests of running ones and waits
+// for them to store what they have fetched until ctx is done. Then it stops
+// the query server and syncs and closes the storage. If ctx is done first, the
+// storage is only closed once the running crawls are over, wait for
+// StorageClosed before exiting.
+func (c *Crawler) Shutdown(ctx context.Context) (err error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	close(c.done)
+	c.mu.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		c.workers.Wait()
+		c.scheduler.Close()
+		close(finished)
+	}()
+	stopped := true
+	select {
+	case <-finished:
+	case <-ctx.Done():
+		log.Printf("Crawl didn't stop in time: %v\n", ctx.Err())
+		err, stopped = ctx.Err(), false
+	}
+
+	if c.server != nil {
+		if e := c.server.Shutdown(ctx); e != nil {
+			c.server.Close()
+			if err == nil {
+				err = e
+			}
+		}
+	}
+	if !stopped {
+		// Crawls still running may be storing posts.
+		go func() {
+			<-finished
+			if err := c.closeStorage(); err != nil {
+				log.Printf("Cannot sync storage: %v\n", err)
+			}
+		}()
+		return
+	}
+	if e := c.closeStorage(); e != nil && err == nil {
+		err = e
+	}
+	return
+}
+
+// StorageClosed returns a channel that is closed once Shutdown has closed the
+// storage.
+func (c *Crawler) StorageClosed() <-chan struct{} {
+	return c.storageClosed
+}
+
+// closeStorage syncs and closes the storage.
+func (c *Crawler) closeStorage() error {
+	defer close(c.storageClosed)
+	err := c.Storage.Sync()
+	c.Storage.Close()
+	return err
+}
@@ -1,13 +1,27 @@
 package crawler
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/golang/protobuf/jsonpb"
+	"github.com/smy20011/s1go/storage"
 	"log"
 	"net/http"
 	"strconv"
 )
 
+const (
+	defaultSearchLimit = 20
+	defaultListLimit   = 100
+)
+
+// threadPage is a page of thread ids served by the query server. Next is the
+// after of the next page, 0 on the last page.
+type threadPage struct {
+	Threads []int `json:"threads"`
+	Next    int   `json:"next,omitempty"`
+}
+
 func (c *Crawler) StartQueryServer() {
 	http.HandleFunc("/storage", func(w http.ResponseWriter, r *http.Request) {
 		id, err := strconv.Atoi(r.URL.Query().Get("id"))
@@ -18,7 +32,7 @@ func (c *Crawler) StartQueryServer() {
 		}
 		thread, err := c.Storage.Get(id)
 		if err != nil {
-			fmt.Fprint(w, "Cannot find thread: %v", err)
+			fmt.Fprintf(w, "Cannot find thread: %v", err)
 			w.WriteHeader(http.StatusNotFound)
 			return
 		}
@@ -26,6 +40,87 @@ func (c *Crawler) StartQueryServer() {
 		marshaler.Marshal(w, thread)
 		w.Header().Set("Content-Type", "application/json")
 	})
-	go http.ListenAndServe(":8080", nil)
+	http.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("q")
+		if len(query) == 0 {
+			http.Error(w, "Missing query", http.StatusBadRequest)
+			return
+		}
+		limit := defaultSearchLimit
+		if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 {
+			limit = l
+		}
+		results, err := c.Storage.Search(query, limit)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Search failed: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(results)
+	})
+	http.HandleFunc("/revisions", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(r.URL.Query().Get("id"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Illegal Id %v", err), http.StatusBadRequest)
+			return
+		}
+		floor, err := strconv.Atoi(r.URL.Query().Get("floor"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Illegal floor %v", err), http.StatusBadRequest)
+			return
+		}
+		thread, err := c.Storage.Get(id)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Cannot find thread: %v", err), http.StatusNotFound)
+			return
+		}
+		for index, post := range thread.Posts {
+			if storage.PostFloor(index, post) == floor {
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(revisionChanges(post))
+				return
+			}
+		}
+		http.Error(w, "Cannot find post", http.StatusNotFound)
+	})
+	http.HandleFunc("/threads", func(w http.ResponseWriter, r *http.Request) {
+		after, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		limit := defaultListLimit
+		if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 {
+			limit = l
+		}
+		var ids []int
+		var err error
+		if forum, e := strconv.Atoi(r.URL.Query().Get("forum")); e == nil {
+			ids, err = c.Storage.ListByForum(forum, after, limit)
+		} else {
+			ids, err = c.Storage.ListThreadIDsAfter(after, limit)
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Cannot list threads: %v", err), http.StatusInternalServerError)
+			return
+		}
+		page := threadPage{Threads: ids}
+		if len(ids) == limit {
+			page.Next = ids[len(ids)-1]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(page)
+	})
+	http.HandleFunc("/failures", func(w http.ResponseWriter, r *http.Request) {
+		failures, err := c.Storage.Failures()
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Cannot list failures: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(failures)
+	})
+	c.server = &http.Server{Addr: ":8080"}
+	go func() {
+		if err := c.server.ListenAndServe(); err != http.ErrServerClosed {
+			log.Printf("Query server failed: %v\n", err)
+		}
+	}()
 	log.Println("Start query server at :8080")
 }
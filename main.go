@@ -1,8 +1,15 @@
 package main
 
 import (
+	"bufio"
+	"context"
 	"flag"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
 	"time"
+	"github.com/smy20011/s1go/client"
 	"github.com/smy20011/s1go/crawler"
 	"os"
 	"os/signal"
@@ -11,13 +18,36 @@ import (
 )
 
 var (
-	interval = flag.Int64("interval", 3600, "Seconds between different fetch")
-	username = flag.String("username", "", "Stage1st username")
-	password = flag.String("password", "", "Stage1st password")
+	interval        = flag.Int64("interval", 300, "Seconds between fetches of forum pages, threads are only visited when due")
+	username        = flag.String("username", "", "Stage1st username")
+	password        = flag.String("password", "", "Stage1st password")
+	questionID      = flag.Int("questionid", 0, "Security question of the account, 0 if none")
+	answer          = flag.String("answer", "", "Answer to the security question")
+	captchaFile     = flag.String("captcha", "captcha.png", "Where to save the captcha image when login asks for one")
+	shutdownTimeout = flag.Duration("shutdowntimeout", 30*time.Second, "How long to wait for running crawls on shutdown")
 )
 
 func main() {
 	flag.Parse()
+	var forums, threads []int
+	switch flag.Arg(0) {
+	case "":
+	case "backfill":
+		var err error
+		if forums, threads, err = parseBackfill(flag.Args()[1:]); err != nil {
+			log.Fatalf("Illegal backfill arguments: %v", err)
+		}
+	case "migrate":
+		flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+		dryRun := flags.Bool("dryrun", false, "Only list the migrations the database needs")
+		flags.Parse(flag.Args()[1:])
+		if err := crawler.MigrateStorage(*dryRun); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	default:
+		log.Fatalf("Unknown command %s", flag.Arg(0))
+	}
 
 	c, err := crawler.NewCrawler()
 	if err != nil {
@@ -25,25 +55,103 @@ func main() {
 	}
 	c.StartQueryServer()
 	defer c.Close()
-	trapCtrlCAndClose(c)
+	ctx, stopped := trapCtrlCAndShutdown(c)
 	if len(*username) > 0 {
-		c.Login(*username, *password)
+		c.S1Client.CaptchaSolver = askCaptcha
+		err := c.LoginContext(ctx, client.Credentials{
+			Username:   *username,
+			Password:   *password,
+			QuestionID: *questionID,
+			Answer:     *answer,
+		})
+		if err != nil {
+			log.Printf("Login failed: %v", err)
+		}
+	}
+
+	go func() {
+		if err := c.RetryFailedContext(ctx); err != nil && err != context.Canceled {
+			log.Printf("Retry failed threads failed: %v", err)
+		}
+	}()
+
+	if len(forums) > 0 || len(threads) > 0 {
+		go func() {
+			if err := c.BackfillContext(ctx, forums, threads); err != nil && err != context.Canceled {
+				log.Printf("Backfill failed: %v", err)
+			}
+		}()
 	}
 
 	trigger := time.Tick(time.Second * time.Duration(*interval))
 	for {
-		c.FetchAllForums()
-		<-trigger
+		c.FetchAllForumsContext(ctx)
+		select {
+		case <-trigger:
+		case <-stopped:
+			return
+		}
 	}
 }
 
-func trapCtrlCAndClose(c *crawler.Crawler) {
+// trapCtrlCAndShutdown shuts c down on SIGINT or SIGTERM. The returned context
+// is cancelled on the signal and the channel is closed when c is shut down and
+// its storage closed.
+func trapCtrlCAndShutdown(c *crawler.Crawler) (context.Context, <-chan struct{}) {
+	signalled, cancelSignalled := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
 	channel := make(chan os.Signal, 2)
 	signal.Notify(channel, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<- channel
+		cancelSignalled()
 		log.Printf("Gracefully shutdown!")
-		c.Close()
-		os.Exit(0)
+		ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+		defer cancel()
+		if err := c.Shutdown(ctx); err != nil {
+			log.Printf("Shutdown: %v", err)
+			log.Printf("Waiting for running crawls to store their posts")
+		}
+		<-c.StorageClosed()
+		close(stopped)
 	}()
+	return signalled, stopped
+}
+
+// askCaptcha saves the captcha image and reads the answer from stdin.
+func askCaptcha(captcha client.Captcha) (string, error) {
+	if err := ioutil.WriteFile(*captchaFile, captcha.Image, 0644); err != nil {
+		return "", err
+	}
+	fmt.Printf("Enter the captcha saved in %s: ", *captchaFile)
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	return strings.TrimSpace(line), err
+}
+
+// parseBackfill parses the arguments of the backfill command, which archives
+// every page of the given forums and threads alongside the normal crawl.
+func parseBackfill(args []string) (forums, threads []int, err error) {
+	flags := flag.NewFlagSet("backfill", flag.ExitOnError)
+	forumList := flags.String("forums", "", "Comma separated ids of forums to backfill")
+	threadList := flags.String("threads", "", "Comma separated ids of threads to backfill")
+	flags.Parse(args)
+	if forums, err = parseIds(*forumList); err != nil {
+		return
+	}
+	threads, err = parseIds(*threadList)
+	return
+}
+
+func parseIds(list string) (ids []int, err error) {
+	for _, field := range strings.Split(list, ",") {
+		if len(strings.TrimSpace(field)) == 0 {
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return
 }
@@ -11,6 +11,11 @@ It has these top-level messages:
 	Post
 	ThreadInfo
 	Thread
+	Inline
+	Block
+	RichContent
+	Rating
+	Revision
 */
 package stage1stpb
 
@@ -29,10 +34,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Inline_Kind int32
+
+const (
+	Inline_TEXT   Inline_Kind = 0
+	Inline_LINK   Inline_Kind = 1
+	Inline_IMAGE  Inline_Kind = 2
+	Inline_SMILEY Inline_Kind = 3
+)
+
+var Inline_Kind_name = map[int32]string{
+	0: "TEXT",
+	1: "LINK",
+	2: "IMAGE",
+	3: "SMILEY",
+}
+var Inline_Kind_value = map[string]int32{
+	"TEXT":   0,
+	"LINK":   1,
+	"IMAGE":  2,
+	"SMILEY": 3,
+}
+
+func (x Inline_Kind) String() string {
+	return proto.EnumName(Inline_Kind_name, int32(x))
+}
+func (Inline_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0} }
+
+type Block_Kind int32
+
+const (
+	Block_PARAGRAPH  Block_Kind = 0
+	Block_QUOTE      Block_Kind = 1
+	Block_ATTACHMENT Block_Kind = 2
+)
+
+var Block_Kind_name = map[int32]string{
+	0: "PARAGRAPH",
+	1: "QUOTE",
+	2: "ATTACHMENT",
+}
+var Block_Kind_value = map[string]int32{
+	"PARAGRAPH":  0,
+	"QUOTE":      1,
+	"ATTACHMENT": 2,
+}
+
+func (x Block_Kind) String() string {
+	return proto.EnumName(Block_Kind_name, int32(x))
+}
+func (Block_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4, 0} }
+
 type Post struct {
-	Content  string `protobuf:"bytes,1,opt,name=content" json:"content,omitempty"`
-	Author   string `protobuf:"bytes,2,opt,name=author" json:"author,omitempty"`
-	PostTime int64  `protobuf:"varint,3,opt,name=post_time,json=postTime" json:"post_time,omitempty"`
+	Content     string       `protobuf:"bytes,1,opt,name=content" json:"content,omitempty"`
+	Author      string       `protobuf:"bytes,2,opt,name=author" json:"author,omitempty"`
+	PostTime    int64        `protobuf:"varint,3,opt,name=post_time,json=postTime" json:"post_time,omitempty"`
+	PostId      int32        `protobuf:"varint,4,opt,name=post_id,json=postId" json:"post_id,omitempty"`
+	Floor       int32        `protobuf:"varint,5,opt,name=floor" json:"floor,omitempty"`
+	AuthorId    int32        `protobuf:"varint,6,opt,name=author_id,json=authorId" json:"author_id,omitempty"`
+	RichContent *RichContent `protobuf:"bytes,7,opt,name=rich_content,json=richContent" json:"rich_content,omitempty"`
+	Signature   string       `protobuf:"bytes,8,opt,name=signature" json:"signature,omitempty"`
+	EditedBy    string       `protobuf:"bytes,9,opt,name=edited_by,json=editedBy" json:"edited_by,omitempty"`
+	EditedAt    int64        `protobuf:"varint,10,opt,name=edited_at,json=editedAt" json:"edited_at,omitempty"`
+	Ratings     []*Rating    `protobuf:"bytes,11,rep,name=ratings" json:"ratings,omitempty"`
+	// Earlier versions of the post, oldest first.
+	Revisions []*Revision `protobuf:"bytes,12,rep,name=revisions" json:"revisions,omitempty"`
+	Deleted   bool        `protobuf:"varint,13,opt,name=deleted" json:"deleted,omitempty"`
 }
 
 func (m *Post) Reset()                    { *m = Post{} }
@@ -61,6 +128,76 @@ func (m *Post) GetPostTime() int64 {
 	return 0
 }
 
+func (m *Post) GetPostId() int32 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *Post) GetFloor() int32 {
+	if m != nil {
+		return m.Floor
+	}
+	return 0
+}
+
+func (m *Post) GetAuthorId() int32 {
+	if m != nil {
+		return m.AuthorId
+	}
+	return 0
+}
+
+func (m *Post) GetRichContent() *RichContent {
+	if m != nil {
+		return m.RichContent
+	}
+	return nil
+}
+
+func (m *Post) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *Post) GetEditedBy() string {
+	if m != nil {
+		return m.EditedBy
+	}
+	return ""
+}
+
+func (m *Post) GetEditedAt() int64 {
+	if m != nil {
+		return m.EditedAt
+	}
+	return 0
+}
+
+func (m *Post) GetRatings() []*Rating {
+	if m != nil {
+		return m.Ratings
+	}
+	return nil
+}
+
+func (m *Post) GetRevisions() []*Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *Post) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type ThreadInfo struct {
 	Rank      int32 `protobuf:"varint,1,opt,name=rank" json:"rank,omitempty"`
 	Replies   int32 `protobuf:"varint,2,opt,name=replies" json:"replies,omitempty"`
@@ -99,6 +236,9 @@ type Thread struct {
 	Title       string        `protobuf:"bytes,3,opt,name=title" json:"title,omitempty"`
 	ThreadInfos []*ThreadInfo `protobuf:"bytes,4,rep,name=thread_infos,json=threadInfos" json:"thread_infos,omitempty"`
 	Posts       []*Post       `protobuf:"bytes,5,rep,name=posts" json:"posts,omitempty"`
+	Author      string        `protobuf:"bytes,6,opt,name=author" json:"author,omitempty"`
+	AuthorId    int32         `protobuf:"varint,7,opt,name=author_id,json=authorId" json:"author_id,omitempty"`
+	Sticky      bool          `protobuf:"varint,8,opt,name=sticky" json:"sticky,omitempty"`
 }
 
 func (m *Thread) Reset()                    { *m = Thread{} }
@@ -141,31 +281,298 @@ func (m *Thread) GetPosts() []*Post {
 	return nil
 }
 
+func (m *Thread) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *Thread) GetAuthorId() int32 {
+	if m != nil {
+		return m.AuthorId
+	}
+	return 0
+}
+
+func (m *Thread) GetSticky() bool {
+	if m != nil {
+		return m.Sticky
+	}
+	return false
+}
+
+type Inline struct {
+	Kind Inline_Kind `protobuf:"varint,1,opt,name=kind,enum=stage1stpb.Inline_Kind" json:"kind,omitempty"`
+	Text string      `protobuf:"bytes,2,opt,name=text" json:"text,omitempty"`
+	Url  string      `protobuf:"bytes,3,opt,name=url" json:"url,omitempty"`
+}
+
+func (m *Inline) Reset()                    { *m = Inline{} }
+func (m *Inline) String() string            { return proto.CompactTextString(m) }
+func (*Inline) ProtoMessage()               {}
+func (*Inline) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Inline) GetKind() Inline_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return Inline_TEXT
+}
+
+func (m *Inline) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Inline) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+type Block struct {
+	Kind Block_Kind `protobuf:"varint,1,opt,name=kind,enum=stage1stpb.Block_Kind" json:"kind,omitempty"`
+	// Content of a paragraph.
+	Inlines []*Inline `protobuf:"bytes,2,rep,name=inlines" json:"inlines,omitempty"`
+	// Author and content of a quote.
+	QuoteAuthor string   `protobuf:"bytes,3,opt,name=quote_author,json=quoteAuthor" json:"quote_author,omitempty"`
+	Blocks      []*Block `protobuf:"bytes,4,rep,name=blocks" json:"blocks,omitempty"`
+	// Link and file name of an attachment.
+	Url  string `protobuf:"bytes,5,opt,name=url" json:"url,omitempty"`
+	Name string `protobuf:"bytes,6,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *Block) Reset()                    { *m = Block{} }
+func (m *Block) String() string            { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()               {}
+func (*Block) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Block) GetKind() Block_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return Block_PARAGRAPH
+}
+
+func (m *Block) GetInlines() []*Inline {
+	if m != nil {
+		return m.Inlines
+	}
+	return nil
+}
+
+func (m *Block) GetQuoteAuthor() string {
+	if m != nil {
+		return m.QuoteAuthor
+	}
+	return ""
+}
+
+func (m *Block) GetBlocks() []*Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *Block) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Block) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RichContent struct {
+	Blocks []*Block `protobuf:"bytes,1,rep,name=blocks" json:"blocks,omitempty"`
+}
+
+func (m *RichContent) Reset()                    { *m = RichContent{} }
+func (m *RichContent) String() string            { return proto.CompactTextString(m) }
+func (*RichContent) ProtoMessage()               {}
+func (*RichContent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *RichContent) GetBlocks() []*Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type Rating struct {
+	User   string `protobuf:"bytes,1,opt,name=user" json:"user,omitempty"`
+	UserId int32  `protobuf:"varint,2,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	Score  int32  `protobuf:"varint,3,opt,name=score" json:"score,omitempty"`
+	Reason string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *Rating) Reset()                    { *m = Rating{} }
+func (m *Rating) String() string            { return proto.CompactTextString(m) }
+func (*Rating) ProtoMessage()               {}
+func (*Rating) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *Rating) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Rating) GetUserId() int32 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *Rating) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Rating) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Revision is a version of a post replaced by an edit or by deletion.
+type Revision struct {
+	Content string `protobuf:"bytes,1,opt,name=content" json:"content,omitempty"`
+	// Hex SHA-1 of content.
+	ContentHash string `protobuf:"bytes,2,opt,name=content_hash,json=contentHash" json:"content_hash,omitempty"`
+	EditedBy    string `protobuf:"bytes,3,opt,name=edited_by,json=editedBy" json:"edited_by,omitempty"`
+	EditedAt    int64  `protobuf:"varint,4,opt,name=edited_at,json=editedAt" json:"edited_at,omitempty"`
+	// When the edit or deletion was noticed.
+	ReplacedAt int64 `protobuf:"varint,5,opt,name=replaced_at,json=replacedAt" json:"replaced_at,omitempty"`
+	// Set if the post was deleted rather than edited.
+	Deleted bool `protobuf:"varint,6,opt,name=deleted" json:"deleted,omitempty"`
+}
+
+func (m *Revision) Reset()                    { *m = Revision{} }
+func (m *Revision) String() string            { return proto.CompactTextString(m) }
+func (*Revision) ProtoMessage()               {}
+func (*Revision) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *Revision) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *Revision) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *Revision) GetEditedBy() string {
+	if m != nil {
+		return m.EditedBy
+	}
+	return ""
+}
+
+func (m *Revision) GetEditedAt() int64 {
+	if m != nil {
+		return m.EditedAt
+	}
+	return 0
+}
+
+func (m *Revision) GetReplacedAt() int64 {
+	if m != nil {
+		return m.ReplacedAt
+	}
+	return 0
+}
+
+func (m *Revision) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Post)(nil), "stage1stpb.Post")
 	proto.RegisterType((*ThreadInfo)(nil), "stage1stpb.ThreadInfo")
 	proto.RegisterType((*Thread)(nil), "stage1stpb.Thread")
+	proto.RegisterType((*Inline)(nil), "stage1stpb.Inline")
+	proto.RegisterType((*Block)(nil), "stage1stpb.Block")
+	proto.RegisterType((*RichContent)(nil), "stage1stpb.RichContent")
+	proto.RegisterType((*Rating)(nil), "stage1stpb.Rating")
+	proto.RegisterType((*Revision)(nil), "stage1stpb.Revision")
+	proto.RegisterEnum("stage1stpb.Inline_Kind", Inline_Kind_name, Inline_Kind_value)
+	proto.RegisterEnum("stage1stpb.Block_Kind", Block_Kind_name, Block_Kind_value)
 }
 
 func init() { proto.RegisterFile("stage1st.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xcd, 0x4a, 0xc4, 0x30,
-	0x10, 0x80, 0xa9, 0xfd, 0xb1, 0x9d, 0x15, 0x91, 0x41, 0x96, 0x88, 0x1e, 0x4a, 0x0f, 0xd2, 0x53,
-	0x41, 0x3d, 0xf9, 0x08, 0xbd, 0x49, 0x58, 0xc1, 0xdb, 0xd2, 0xb5, 0xa9, 0x5b, 0xdc, 0x26, 0x21,
-	0x99, 0x7d, 0x38, 0xdf, 0x4e, 0x92, 0xb4, 0xd6, 0xdb, 0x7c, 0x33, 0xcc, 0x37, 0x3f, 0x70, 0x6d,
-	0xa9, 0xfb, 0x12, 0x4f, 0x96, 0x1a, 0x6d, 0x14, 0x29, 0x84, 0x85, 0xf5, 0xa1, 0x7a, 0x87, 0xe4,
-	0x4d, 0x59, 0x42, 0x06, 0x97, 0x9f, 0x4a, 0x92, 0x90, 0xc4, 0xa2, 0x32, 0xaa, 0x0b, 0xbe, 0x20,
-	0x6e, 0x21, 0xeb, 0xce, 0x74, 0x54, 0x86, 0x5d, 0xf8, 0xc2, 0x4c, 0x78, 0x0f, 0x85, 0x56, 0x96,
-	0xf6, 0x34, 0x4e, 0x82, 0xc5, 0x65, 0x54, 0xc7, 0x3c, 0x77, 0x89, 0xdd, 0x38, 0x89, 0xea, 0x03,
-	0x60, 0x77, 0x34, 0xa2, 0xeb, 0x5b, 0x39, 0x28, 0x44, 0x48, 0x4c, 0x27, 0xbf, 0xbd, 0x39, 0xe5,
-	0x3e, 0x76, 0x03, 0x8d, 0xd0, 0xa7, 0x51, 0x58, 0xef, 0x4d, 0xf9, 0x82, 0xf8, 0x00, 0x85, 0x73,
-	0x5a, 0xea, 0x26, 0x3d, 0x8b, 0xd7, 0x44, 0xf5, 0x13, 0x41, 0x16, 0xd4, 0x6e, 0x03, 0xf2, 0xd1,
-	0x7e, 0xec, 0x67, 0x77, 0x1e, 0x12, 0x6d, 0x8f, 0x77, 0x90, 0x0f, 0xca, 0x9c, 0x27, 0x57, 0x9b,
-	0x07, 0x78, 0x6e, 0x7b, 0xbc, 0x85, 0x94, 0x46, 0x3a, 0x85, 0xad, 0x0b, 0x1e, 0x00, 0x5f, 0xe1,
-	0x6a, 0xb1, 0xc9, 0x41, 0x59, 0x96, 0x94, 0x71, 0xbd, 0x79, 0xde, 0x36, 0xeb, 0xb3, 0x9a, 0xf5,
-	0x24, 0xbe, 0xa1, 0xbf, 0xd8, 0xe2, 0x23, 0xa4, 0xee, 0x72, 0xcb, 0x52, 0xdf, 0x73, 0xf3, 0xbf,
-	0xc7, 0x7d, 0x97, 0x87, 0xf2, 0x21, 0xf3, 0xff, 0x7f, 0xf9, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe4,
-	0x30, 0x87, 0x02, 0x91, 0x01, 0x00, 0x00,
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x5e, 0x27, 0xb6, 0x63, 0x1f, 0x67, 0x2b, 0x33, 0x5a, 0x75, 0x8d, 0x40, 0x22, 0xeb, 0x0b,
+	0x14, 0x60, 0x15, 0xb1, 0xe5, 0x06, 0xb8, 0xf3, 0xae, 0xa2, 0xad, 0xb5, 0x6d, 0x29, 0x83, 0x91,
+	0xca, 0x55, 0xe4, 0xd8, 0x93, 0x66, 0x94, 0xc4, 0x13, 0x66, 0x26, 0x88, 0xbe, 0x00, 0xdc, 0xf1,
+	0x2e, 0xdc, 0xf3, 0x70, 0x68, 0x7e, 0x5c, 0x3b, 0xad, 0xd4, 0xbd, 0xca, 0xf9, 0xce, 0x77, 0x66,
+	0x7c, 0x7e, 0xbe, 0x39, 0x81, 0x13, 0x21, 0xcb, 0x5b, 0xf2, 0x46, 0xc8, 0xd9, 0x9e, 0x33, 0xc9,
+	0x10, 0xb4, 0x78, 0xbf, 0x4c, 0xff, 0x1d, 0x82, 0x7b, 0xcd, 0x84, 0x44, 0x09, 0x8c, 0x2a, 0xd6,
+	0x48, 0xd2, 0xc8, 0xc4, 0x99, 0x38, 0xd3, 0x10, 0xb7, 0x10, 0x9d, 0x82, 0x5f, 0x1e, 0xe4, 0x9a,
+	0xf1, 0x64, 0xa0, 0x09, 0x8b, 0xd0, 0x67, 0x10, 0xee, 0x99, 0x90, 0x0b, 0x49, 0x77, 0x24, 0x19,
+	0x4e, 0x9c, 0xe9, 0x10, 0x07, 0xca, 0x51, 0xd0, 0x1d, 0x41, 0x2f, 0x61, 0xa4, 0x49, 0x5a, 0x27,
+	0xee, 0xc4, 0x99, 0x7a, 0xd8, 0x57, 0x30, 0xaf, 0xd1, 0x0b, 0xf0, 0x56, 0x5b, 0xc6, 0x78, 0xe2,
+	0x69, 0xb7, 0x01, 0xea, 0x2e, 0x73, 0xab, 0x3a, 0xe0, 0x6b, 0x26, 0x30, 0x8e, 0xbc, 0x46, 0x3f,
+	0xc2, 0x98, 0xd3, 0x6a, 0xbd, 0x68, 0xf3, 0x1b, 0x4d, 0x9c, 0x69, 0x74, 0xf6, 0x72, 0xd6, 0x95,
+	0x31, 0xc3, 0xb4, 0x5a, 0xbf, 0x33, 0x34, 0x8e, 0x78, 0x07, 0xd0, 0xe7, 0x10, 0x0a, 0x7a, 0xdb,
+	0x94, 0xf2, 0xc0, 0x49, 0x12, 0xe8, 0xfc, 0x3b, 0x87, 0xfa, 0x2c, 0xa9, 0xa9, 0x24, 0xf5, 0x62,
+	0x79, 0x97, 0x84, 0x9a, 0x0d, 0x8c, 0xe3, 0xed, 0x5d, 0x8f, 0x2c, 0x65, 0x02, 0xa6, 0x3e, 0xe3,
+	0xc8, 0x24, 0x7a, 0x0d, 0x23, 0x5e, 0x4a, 0xda, 0xdc, 0x8a, 0x24, 0x9a, 0x0c, 0xa7, 0xd1, 0x19,
+	0x3a, 0x4a, 0x47, 0x53, 0xb8, 0x0d, 0x41, 0x67, 0x10, 0x72, 0xf2, 0x07, 0x15, 0x94, 0x35, 0x22,
+	0x19, 0xeb, 0xf8, 0x17, 0x47, 0xf1, 0x96, 0xc4, 0x5d, 0x98, 0x1a, 0x48, 0x4d, 0xb6, 0x44, 0x92,
+	0x3a, 0x79, 0x3e, 0x71, 0xa6, 0x01, 0x6e, 0x61, 0x7a, 0x03, 0x50, 0xac, 0x39, 0x29, 0xeb, 0xbc,
+	0x59, 0x31, 0x84, 0xc0, 0xe5, 0x65, 0xb3, 0xd1, 0x53, 0xf3, 0xb0, 0xb6, 0xd5, 0x59, 0x4e, 0xf6,
+	0x5b, 0x4a, 0x84, 0x9e, 0x99, 0x87, 0x5b, 0xa8, 0xfa, 0xa1, 0xe6, 0x25, 0x64, 0xb9, 0xdb, 0xdb,
+	0xa1, 0x75, 0x8e, 0xf4, 0xaf, 0x01, 0xf8, 0xe6, 0x6a, 0x55, 0xbd, 0xd4, 0x96, 0x9a, 0x88, 0xb9,
+	0x3b, 0x30, 0x8e, 0xbc, 0x46, 0x9f, 0x42, 0xb0, 0x62, 0xfc, 0xb0, 0x53, 0x9c, 0xfd, 0x80, 0xc6,
+	0x66, 0xbe, 0x92, 0xca, 0xad, 0x51, 0x44, 0x88, 0x0d, 0x40, 0x3f, 0xc0, 0xb8, 0xbd, 0xad, 0x59,
+	0x31, 0x91, 0xb8, 0xba, 0x07, 0xa7, 0xfd, 0x1e, 0x74, 0x25, 0xe1, 0x48, 0xde, 0xdb, 0x02, 0x7d,
+	0x09, 0x9e, 0x92, 0x8e, 0x48, 0x3c, 0x7d, 0x26, 0xee, 0x9f, 0x51, 0xca, 0xc5, 0x86, 0xee, 0xc9,
+	0xd4, 0x7f, 0x28, 0xd3, 0x4e, 0x5a, 0xa3, 0x07, 0xd2, 0x3a, 0x05, 0x5f, 0x48, 0x5a, 0x6d, 0xee,
+	0xb4, 0x36, 0x02, 0x6c, 0x51, 0xfa, 0x8f, 0x03, 0x7e, 0xde, 0x6c, 0x69, 0x43, 0xd0, 0x37, 0xe0,
+	0x6e, 0x68, 0x63, 0x7a, 0x70, 0x72, 0xac, 0x3a, 0x13, 0x31, 0xfb, 0x40, 0x9b, 0x1a, 0xeb, 0x20,
+	0x35, 0x0c, 0x49, 0xfe, 0x94, 0xf6, 0xa5, 0x68, 0x1b, 0xc5, 0x30, 0x3c, 0xf0, 0xad, 0xed, 0x87,
+	0x32, 0xd3, 0x37, 0xe0, 0xaa, 0x33, 0x28, 0x00, 0xb7, 0x98, 0xdf, 0x14, 0xf1, 0x33, 0x65, 0x5d,
+	0xe4, 0x57, 0x1f, 0x62, 0x07, 0x85, 0xe0, 0xe5, 0x97, 0xd9, 0xfb, 0x79, 0x3c, 0x40, 0x00, 0xfe,
+	0x2f, 0x97, 0xf9, 0xc5, 0xfc, 0xb7, 0x78, 0x98, 0xfe, 0x3d, 0x00, 0xef, 0xed, 0x96, 0x55, 0x1b,
+	0xf4, 0xf5, 0x51, 0x3e, 0x47, 0x2d, 0xd4, 0x01, 0xfd, 0x74, 0x5e, 0xc3, 0x88, 0xea, 0x1c, 0x95,
+	0x0e, 0x1e, 0xa9, 0xd4, 0xa4, 0x8f, 0xdb, 0x10, 0xf4, 0x0a, 0xc6, 0xbf, 0x1f, 0x98, 0x24, 0x0b,
+	0xdb, 0x47, 0x93, 0x71, 0xa4, 0x7d, 0x99, 0x69, 0xe6, 0x57, 0xe0, 0x2f, 0xd5, 0x47, 0xda, 0x09,
+	0x7e, 0xf2, 0xe8, 0xf3, 0xd8, 0x06, 0xb4, 0x65, 0x7b, 0xf7, 0x65, 0xab, 0xe6, 0x34, 0xe5, 0x8e,
+	0xd8, 0xf9, 0x68, 0x3b, 0xfd, 0xd6, 0xb6, 0xe2, 0x39, 0x84, 0xd7, 0x19, 0xce, 0xde, 0xe3, 0xec,
+	0xfa, 0x3c, 0x7e, 0xa6, 0xba, 0xf0, 0xf3, 0xaf, 0x3f, 0x15, 0xf3, 0xd8, 0x41, 0x27, 0x00, 0x59,
+	0x51, 0x64, 0xef, 0xce, 0x2f, 0xe7, 0x57, 0x45, 0x3c, 0x48, 0xbf, 0x87, 0xa8, 0xf7, 0xda, 0x7b,
+	0x19, 0x39, 0x1f, 0xc9, 0x28, 0xad, 0xc0, 0x37, 0x0f, 0x53, 0x65, 0x72, 0x10, 0x84, 0xdb, 0x4d,
+	0xa7, 0x6d, 0xb5, 0xb1, 0xd4, 0x6f, 0x27, 0x69, 0x5f, 0x41, 0xa3, 0x68, 0x51, 0x31, 0x6e, 0x14,
+	0xed, 0x61, 0x03, 0x94, 0x72, 0x38, 0x29, 0x05, 0x6b, 0xf4, 0x7e, 0x0b, 0xb1, 0x45, 0xe9, 0x7f,
+	0x0e, 0x04, 0xed, 0x73, 0x7e, 0x62, 0xa9, 0xbe, 0x82, 0xb1, 0x35, 0x17, 0xeb, 0x52, 0xac, 0xad,
+	0x60, 0x22, 0xeb, 0x3b, 0x2f, 0xc5, 0xfa, 0x78, 0x39, 0x0d, 0x9f, 0x5a, 0x4e, 0xee, 0x83, 0xe5,
+	0xf4, 0x05, 0x44, 0xea, 0xbd, 0x97, 0x95, 0xa1, 0x3d, 0x4d, 0x43, 0xeb, 0xca, 0x64, 0x7f, 0xb7,
+	0xf8, 0x47, 0xbb, 0x65, 0xe9, 0xeb, 0xbf, 0x88, 0xef, 0xfe, 0x1f, 0x00, 0x7e, 0x8e, 0xc8, 0x2b,
+	0x34, 0x06, 0x00, 0x00,
 }
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/boltdb/bolt"
+)
+
+// BACKFILL_BUCKET maps forums and threads being backfilled to the next page
+// to fetch, so an interrupted backfill can resume.
+var BACKFILL_BUCKET = []byte("backfill")
+
+// ForumCursor is the key of the backfill cursor of forum forumId.
+func ForumCursor(forumId int) string {
+	return fmt.Sprintf("forum/%d", forumId)
+}
+
+// ThreadCursor is the key of the backfill cursor of thread threadId.
+func ThreadCursor(threadId int) string {
+	return fmt.Sprintf("thread/%d", threadId)
+}
+
+// BackfillCursor returns the next page to backfill at cursor, 0 if there is
+// no backfill in progress.
+func (s *Storage) BackfillCursor(cursor string) (page int, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(BACKFILL_BUCKET).Get([]byte(cursor))
+		if value == nil {
+			return nil
+		}
+		page, err = strconv.Atoi(string(value))
+		return err
+	})
+	return
+}
+
+// SetBackfillCursor records that backfill at cursor continues at page.
+func (s *Storage) SetBackfillCursor(cursor string, page int) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(BACKFILL_BUCKET).Put([]byte(cursor), []byte(fmt.Sprint(page)))
+	})
+}
+
+// ClearBackfillCursor records that backfill at cursor is done.
+func (s *Storage) ClearBackfillCursor(cursor string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(BACKFILL_BUCKET).Delete([]byte(cursor))
+	})
+}
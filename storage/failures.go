@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// FAILURES_BUCKET maps threads whose last visit failed to the kind and unix
+// time of the failure, so they can be inspected and visited again.
+var FAILURES_BUCKET = []byte("failures")
+
+// Failure is why and when a visit of a thread failed.
+type Failure struct {
+	Kind string    `json:"kind"`
+	Time time.Time `json:"time"`
+}
+
+// RecordFailure records that the last visit of thread threadId failed.
+func (s *Storage) RecordFailure(threadId int, failure Failure) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(FAILURES_BUCKET).Put(threadKey(threadId), []byte(fmt.Sprintf("%d %s", failure.Time.Unix(), failure.Kind)))
+	})
+}
+
+// ClearFailure forgets the failure of thread threadId. Threads that didn't
+// fail don't cost a write.
+func (s *Storage) ClearFailure(threadId int) error {
+	failed := false
+	s.db.View(func(tx *bolt.Tx) error {
+		failed = tx.Bucket(FAILURES_BUCKET).Get(threadKey(threadId)) != nil
+		return nil
+	})
+	if !failed {
+		return nil
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(FAILURES_BUCKET).Delete(threadKey(threadId))
+	})
+}
+
+// Failures returns the threads whose last visit failed.
+func (s *Storage) Failures() (failures map[int]Failure, err error) {
+	failures = map[int]Failure{}
+	err = s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(FAILURES_BUCKET).ForEach(func(key, value []byte) error {
+			fields := strings.SplitN(string(value), " ", 2)
+			if len(fields) != 2 {
+				return fmt.Errorf("malformed failure of thread %d: %q", keyThreadID(key), value)
+			}
+			unix, err := strconv.ParseInt(fields[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			failures[keyThreadID(key)] = Failure{Kind: fields[1], Time: time.Unix(unix, 0)}
+			return nil
+		})
+	})
+	return
+}
@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	"github.com/smy20011/s1go/stage1stpb"
+)
+
+var (
+	// FORUM_THREADS_BUCKET maps forum id and thread id to the last activity
+	// of the thread.
+	FORUM_THREADS_BUCKET = []byte("index/forumthreads")
+	// FORUM_INDEX_BUCKET maps forum id, last activity and thread id to
+	// nothing, listing the threads of each forum by recency.
+	FORUM_INDEX_BUCKET = []byte("index/forum")
+	// ACTIVITY_INDEX_BUCKET maps last activity and thread id to nothing.
+	ACTIVITY_INDEX_BUCKET = []byte("index/activity")
+	// AUTHOR_INDEX_BUCKET maps author, post time, thread id and floor to
+	// nothing. Authors are names, the archiver doesn't see their ids.
+	AUTHOR_INDEX_BUCKET = []byte("index/author")
+	// POST_TIME_INDEX_BUCKET maps post time, thread id and floor to nothing.
+	POST_TIME_INDEX_BUCKET = []byte("index/posttime")
+)
+
+var indexBuckets = [][]byte{FORUM_THREADS_BUCKET, FORUM_INDEX_BUCKET, ACTIVITY_INDEX_BUCKET, AUTHOR_INDEX_BUCKET, POST_TIME_INDEX_BUCKET}
+
+// PostRef is a stored post with where it is.
+type PostRef struct {
+	ThreadID int
+	Floor    int
+	Post     *stage1stpb.Post
+}
+
+func (s *Storage) RecentThreads(forumId, limit int) (ids []int, err error) {
+	bucket, prefix := ACTIVITY_INDEX_BUCKET, []byte{}
+	if forumId != 0 {
+		bucket, prefix = FORUM_INDEX_BUCKET, threadKey(forumId)
+	}
+	err = s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucket).Cursor()
+		// Seek past the last key with prefix.
+		k, _ := c.Seek(append(append([]byte{}, prefix...), bytes.Repeat([]byte{0xff}, 12)...))
+		if k == nil {
+			k, _ = c.Last()
+		} else {
+			k, _ = c.Prev()
+		}
+		for ; k != nil && bytes.HasPrefix(k, prefix) && (limit <= 0 || len(ids) < limit); k, _ = c.Prev() {
+			ids = append(ids, keyThreadID(k[len(k)-4:]))
+		}
+		return nil
+	})
+	return
+}
+
+func (s *Storage) PostsByAuthor(author string, from, to time.Time) ([]PostRef, error) {
+	return s.postsBetween(AUTHOR_INDEX_BUCKET, authorPrefix(author), from, to)
+}
+
+func (s *Storage) PostsBetween(from, to time.Time) ([]PostRef, error) {
+	return s.postsBetween(POST_TIME_INDEX_BUCKET, nil, from, to)
+}
+
+// postsBetween returns the posts in the index bucket posted in [from, to)
+// under prefix.
+func (s *Storage) postsBetween(bucket, prefix []byte, from, to time.Time) (posts []PostRef, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		start, end := timeKey(prefix, from.Unix()), timeKey(prefix, to.Unix())
+		c := tx.Bucket(bucket).Cursor()
+		for k, _ := c.Seek(start); k != nil && bytes.Compare(k, end) < 0; k, _ = c.Next() {
+			threadId, floor := keyThreadID(k[len(k)-8:]), int(binary.BigEndian.Uint32(k[len(k)-4:]))
+			post := &stage1stpb.Post{}
+			if err := proto.Unmarshal(tx.Bucket(POST_BUCKET).Get(postKey(threadId, floor)), post); err != nil {
+				return err
+			}
+			posts = append(posts, PostRef{threadId, floor, post})
+		}
+		return nil
+	})
+	return
+}
+
+// updateIndexes updates the indexes of thread threadId after it was written
+// over old, without posts and visits, and its posts changed. thread is nil if
+// it was deleted.
+func updateIndexes(tx *bolt.Tx, threadId int, old, thread *stage1stpb.Thread, posts []rowChange) error {
+	forums := tx.Bucket(FORUM_THREADS_BUCKET)
+	id := threadKey(threadId)
+	var oldActivity, activity int64
+	if old.ThreadId != 0 {
+		oldActivity = int64(readUint64(forums.Get(forumThreadKey(int(old.ForumId), threadId))))
+	}
+	if thread != nil {
+		activity = lastActivity(thread)
+	}
+	if old.ThreadId == 0 || thread == nil || old.ForumId != thread.ForumId || oldActivity != activity {
+		if old.ThreadId != 0 {
+			if err := forums.Delete(forumThreadKey(int(old.ForumId), threadId)); err != nil {
+				return err
+			}
+			if err := tx.Bucket(FORUM_INDEX_BUCKET).Delete(append(timeKey(threadKey(int(old.ForumId)), oldActivity), id...)); err != nil {
+				return err
+			}
+			if err := tx.Bucket(ACTIVITY_INDEX_BUCKET).Delete(append(timeKey(nil, oldActivity), id...)); err != nil {
+				return err
+			}
+		}
+		if thread != nil {
+			if err := forums.Put(forumThreadKey(int(thread.ForumId), threadId), encodeUint64(uint64(activity))); err != nil {
+				return err
+			}
+			if err := tx.Bucket(FORUM_INDEX_BUCKET).Put(append(timeKey(threadKey(int(thread.ForumId)), activity), id...), nil); err != nil {
+				return err
+			}
+			if err := tx.Bucket(ACTIVITY_INDEX_BUCKET).Put(append(timeKey(nil, activity), id...), nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	authors, times := tx.Bucket(AUTHOR_INDEX_BUCKET), tx.Bucket(POST_TIME_INDEX_BUCKET)
+	for _, change := range posts {
+		before, after := &stage1stpb.Post{}, &stage1stpb.Post{}
+		if err := proto.Unmarshal(change.before, before); err != nil {
+			return err
+		}
+		if err := proto.Unmarshal(change.after, after); err != nil {
+			return err
+		}
+		if change.before != nil && change.after != nil && before.Author == after.Author && before.PostTime == after.PostTime {
+			continue
+		}
+		if change.before != nil {
+			if err := authors.Delete(append(timeKey(authorPrefix(before.Author), before.PostTime), change.key...)); err != nil {
+				return err
+			}
+			if err := times.Delete(append(timeKey(nil, before.PostTime), change.key...)); err != nil {
+				return err
+			}
+		}
+		if change.after != nil {
+			if err := authors.Put(append(timeKey(authorPrefix(after.Author), after.PostTime), change.key...), nil); err != nil {
+				return err
+			}
+			if err := times.Put(append(timeKey(nil, after.PostTime), change.key...), nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// lastActivity returns the time of the latest post of thread.
+func lastActivity(thread *stage1stpb.Thread) (last int64) {
+	for _, post := range thread.Posts {
+		if post.PostTime > last {
+			last = post.PostTime
+		}
+	}
+	return
+}
+
+// authorPrefix starts the keys of the posts of author. Names can't contain
+// NUL, which ends them so that an author isn't a prefix of another.
+func authorPrefix(author string) []byte {
+	return append([]byte(author), 0)
+}
+
+func forumThreadKey(forumId, threadId int) []byte {
+	return append(threadKey(forumId), threadKey(threadId)...)
+}
+
+// timeKey returns prefix followed by unix time t.
+func timeKey(prefix []byte, t int64) []byte {
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], uint64(t))
+	return key
+}
@@ -0,0 +1,274 @@
+package storage
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/smy20011/s1go/stage1stpb"
+)
+
+// MemoryStore is a Store in memory, for tests.
+type MemoryStore struct {
+	mu         sync.Mutex
+	threads    map[int]*stage1stpb.Thread
+	restricted map[int]string
+	failures   map[int]Failure
+	nextVisits map[int]time.Time
+	cursors    map[string]int
+}
+
+var _ Store = &MemoryStore{}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		threads:    map[int]*stage1stpb.Thread{},
+		restricted: map[int]string{},
+		failures:   map[int]Failure{},
+		nextVisits: map[int]time.Time{},
+		cursors:    map[string]int{},
+	}
+}
+
+func (s *MemoryStore) Get(threadId int) (*stage1stpb.Thread, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	thread, found := s.threads[threadId]
+	if !found {
+		return &stage1stpb.Thread{}, nil
+	}
+	return proto.Clone(thread).(*stage1stpb.Thread), nil
+}
+
+func (s *MemoryStore) Put(thread *stage1stpb.Thread) error {
+	if err := checkFloors(thread); err != nil {
+		return err
+	}
+	thread = proto.Clone(thread).(*stage1stpb.Thread)
+	SortPosts(thread.Posts)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.threads[int(thread.ThreadId)] = thread
+	return nil
+}
+
+func (s *MemoryStore) Delete(threadId int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.threads, threadId)
+	delete(s.restricted, threadId)
+	delete(s.failures, threadId)
+	delete(s.nextVisits, threadId)
+	return nil
+}
+
+func (s *MemoryStore) ListThreadIDs() ([]int, error) {
+	return s.ListThreadIDsAfter(0, 0)
+}
+
+func (s *MemoryStore) ListThreadIDsAfter(after, limit int) ([]int, error) {
+	return s.listThreadIDs(func(thread *stage1stpb.Thread) bool {
+		return int(thread.ThreadId) > after
+	}, limit), nil
+}
+
+func (s *MemoryStore) ListByForum(forumId, after, limit int) ([]int, error) {
+	return s.listThreadIDs(func(thread *stage1stpb.Thread) bool {
+		return int(thread.ForumId) == forumId && int(thread.ThreadId) > after
+	}, limit), nil
+}
+
+// listThreadIDs returns the first limit ids of threads matching match in
+// order, all of them if limit isn't positive.
+func (s *MemoryStore) listThreadIDs(match func(*stage1stpb.Thread) bool, limit int) []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := []int{}
+	for id, thread := range s.threads {
+		if match(thread) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids
+}
+
+func (s *MemoryStore) Scan(fn func(*stage1stpb.Thread) error) error {
+	return s.ScanAfter(0, fn)
+}
+
+func (s *MemoryStore) ScanAfter(after int, fn func(*stage1stpb.Thread) error) error {
+	ids, _ := s.ListThreadIDsAfter(after, 0)
+	for _, id := range ids {
+		thread, _ := s.Get(id)
+		if thread.ThreadId == 0 {
+			// Deleted while scanning.
+			continue
+		}
+		if err := fn(thread); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Posts(threadId int) ([]*stage1stpb.Post, error) {
+	thread, err := s.Get(threadId)
+	return thread.Posts, err
+}
+
+func (s *MemoryStore) Snapshots(threadId int) ([]*stage1stpb.ThreadInfo, error) {
+	thread, err := s.Get(threadId)
+	return thread.ThreadInfos, err
+}
+
+func (s *MemoryStore) LastSnapshot(threadId int) (*stage1stpb.ThreadInfo, error) {
+	thread, err := s.Get(threadId)
+	if err != nil || len(thread.ThreadInfos) == 0 {
+		return nil, err
+	}
+	return thread.ThreadInfos[len(thread.ThreadInfos)-1], nil
+}
+
+func (s *MemoryStore) Search(query string, limit int) ([]SearchResult, error) {
+	return scanSearch(s, query, limit)
+}
+
+func (s *MemoryStore) RecentThreads(forumId, limit int) ([]int, error) {
+	ids := s.listThreadIDs(func(thread *stage1stpb.Thread) bool {
+		return forumId == 0 || int(thread.ForumId) == forumId
+	}, 0)
+	activities := map[int]int64{}
+	for _, id := range ids {
+		thread, _ := s.Get(id)
+		activities[id] = lastActivity(thread)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if activities[ids[i]] != activities[ids[j]] {
+			return activities[ids[i]] > activities[ids[j]]
+		}
+		return ids[i] > ids[j]
+	})
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids, nil
+}
+
+func (s *MemoryStore) PostsByAuthor(author string, from, to time.Time) ([]PostRef, error) {
+	return s.postsBetween(func(post *stage1stpb.Post) bool {
+		return post.Author == author
+	}, from, to), nil
+}
+
+func (s *MemoryStore) PostsBetween(from, to time.Time) ([]PostRef, error) {
+	return s.postsBetween(func(*stage1stpb.Post) bool { return true }, from, to), nil
+}
+
+// postsBetween returns the posts matching match posted in [from, to) ordered
+// like the post time index.
+func (s *MemoryStore) postsBetween(match func(*stage1stpb.Post) bool, from, to time.Time) (posts []PostRef) {
+	s.Scan(func(thread *stage1stpb.Thread) error {
+		for index, post := range thread.Posts {
+			if match(post) && post.PostTime >= from.Unix() && post.PostTime < to.Unix() {
+				posts = append(posts, PostRef{int(thread.ThreadId), PostFloor(index, post), post})
+			}
+		}
+		return nil
+	})
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].Post.PostTime < posts[j].Post.PostTime
+	})
+	return
+}
+
+func (s *MemoryStore) MarkRestricted(threadId int, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.restricted[threadId] = reason
+	return nil
+}
+
+func (s *MemoryStore) ClearRestricted(threadId int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.restricted, threadId)
+	return nil
+}
+
+func (s *MemoryStore) Restricted() (map[int]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	threads := map[int]string{}
+	for id, reason := range s.restricted {
+		threads[id] = reason
+	}
+	return threads, nil
+}
+
+func (s *MemoryStore) RecordFailure(threadId int, failure Failure) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[threadId] = Failure{Kind: failure.Kind, Time: time.Unix(failure.Time.Unix(), 0)}
+	return nil
+}
+
+func (s *MemoryStore) ClearFailure(threadId int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.failures, threadId)
+	return nil
+}
+
+func (s *MemoryStore) Failures() (map[int]Failure, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	failures := map[int]Failure{}
+	for id, failure := range s.failures {
+		failures[id] = failure
+	}
+	return failures, nil
+}
+
+func (s *MemoryStore) SetNextVisit(threadId int, next time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextVisits[threadId] = time.Unix(next.Unix(), 0)
+	return nil
+}
+
+func (s *MemoryStore) NextVisit(threadId int) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.nextVisits[threadId], nil
+}
+
+func (s *MemoryStore) BackfillCursor(cursor string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursors[cursor], nil
+}
+
+func (s *MemoryStore) SetBackfillCursor(cursor string, page int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[cursor] = page
+	return nil
+}
+
+func (s *MemoryStore) ClearBackfillCursor(cursor string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cursors, cursor)
+	return nil
+}
+
+func (s *MemoryStore) Sync() error {
+	return nil
+}
+
+func (s *MemoryStore) Close() {}
@@ -0,0 +1,408 @@
+package storage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	"github.com/smy20011/s1go/stage1stpb"
+)
+
+// META_BUCKET holds facts about the database itself.
+var META_BUCKET = []byte("meta")
+
+// versionKey maps to the schema version of the database in META_BUCKET.
+// Databases written before versions were recorded have none, which is
+// version 0.
+var versionKey = []byte("version")
+
+// migrateBatch is the number of threads moved out of BUCKET per transaction.
+const migrateBatch = 1000
+
+// lockTimeout is how long to wait for another process to close the database.
+var lockTimeout = 10 * time.Second
+
+// Options of OpenWithOptions.
+type Options struct {
+	// Backup copies the database to <filename>.v<version>.bak before
+	// migrating it.
+	Backup bool
+}
+
+// Migration upgrades a database of schema version Version-1 to Version.
+// Migrations must be safe to run again when an earlier run was interrupted.
+type Migration struct {
+	Version     int
+	Description string
+	Migrate     func(db *bolt.DB) error
+}
+
+// migrations are applied in order by Open. Add new ones at the end.
+var migrations = []Migration{
+	{1, "Create the buckets", createBuckets},
+	{2, "Split threads into thread, post and history buckets", migrateThreads},
+	{3, "Key threads by big-endian ids", migrateThreadKeys},
+	{4, "Index threads by forum and activity, and posts by author and time", buildIndexes},
+	{5, "Rebuild the search index keyed by floor", reindexSearch},
+	{6, "Index the threads of each forum by id", buildIndexes},
+	{7, "Record failed visits of threads", createFailuresBucket},
+}
+
+// SchemaVersion is the version of databases written by this package.
+func SchemaVersion() int {
+	return migrations[len(migrations)-1].Version
+}
+
+// PendingMigrations returns the migrations Open would apply to the database in
+// filename, without changing it.
+func PendingMigrations(filename string) ([]Migration, error) {
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return migrations, nil
+	}
+	db, err := openBolt(filename, true)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	version, err := schemaVersion(db)
+	if err != nil {
+		return nil, err
+	}
+	return pendingMigrations(version)
+}
+
+// openBolt opens the database in filename, giving up after lockTimeout if
+// another process has it open.
+func openBolt(filename string, readOnly bool) (*bolt.DB, error) {
+	db, err := bolt.Open(filename, 0600, &bolt.Options{Timeout: lockTimeout, ReadOnly: readOnly})
+	if err == bolt.ErrTimeout {
+		return nil, fmt.Errorf("database %s is in use by another process", filename)
+	}
+	return db, err
+}
+
+func pendingMigrations(version int) ([]Migration, error) {
+	if version > SchemaVersion() {
+		return nil, fmt.Errorf("schema version %d of database is newer than %d", version, SchemaVersion())
+	}
+	for i, migration := range migrations {
+		if migration.Version > version {
+			return migrations[i:], nil
+		}
+	}
+	return nil, nil
+}
+
+// migrate applies the pending migrations to db, stored in filename, and
+// records the version after each one.
+func migrate(db *bolt.DB, filename string, options Options) error {
+	version, err := schemaVersion(db)
+	if err != nil {
+		return err
+	}
+	pending, err := pendingMigrations(version)
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+	if options.Backup && !isEmpty(db) {
+		backup := fmt.Sprintf("%s.v%d.bak", filename, version)
+		log.Printf("Backup database to %s\n", backup)
+		err := db.View(func(tx *bolt.Tx) error {
+			return tx.CopyFile(backup, 0600)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, migration := range pending {
+		log.Printf("Migrate database to version %d: %s\n", migration.Version, migration.Description)
+		if err := migration.Migrate(db); err != nil {
+			return fmt.Errorf("migration to version %d: %v", migration.Version, err)
+		}
+		err := db.Update(func(tx *bolt.Tx) error {
+			meta, err := tx.CreateBucketIfNotExists(META_BUCKET)
+			if err != nil {
+				return err
+			}
+			return meta.Put(versionKey, []byte(strconv.Itoa(migration.Version)))
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func schemaVersion(db *bolt.DB) (version int, err error) {
+	err = db.View(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(META_BUCKET)
+		if meta == nil {
+			return nil
+		}
+		version, err = strconv.Atoi(string(meta.Get(versionKey)))
+		return err
+	})
+	return
+}
+
+// isEmpty reports whether db has just been created.
+func isEmpty(db *bolt.DB) bool {
+	empty := true
+	db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func([]byte, *bolt.Bucket) error {
+			empty = false
+			return nil
+		})
+	})
+	return empty
+}
+
+func createBuckets(db *bolt.DB) error {
+	return db.Update(func(tx *bolt.Tx) (err error) {
+		for _, bucket := range [][]byte{BUCKET, SEARCH_BUCKET, INDEXED_BUCKET, RESTRICTED_BUCKET, SCHEDULE_BUCKET, BACKFILL_BUCKET} {
+			if _, err = tx.CreateBucketIfNotExists(bucket); err != nil {
+				return
+			}
+		}
+		return
+	})
+}
+
+func createFailuresBucket(db *bolt.DB) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(FAILURES_BUCKET)
+		return err
+	})
+}
+
+// migrateThreads moves threads stored whole in BUCKET to the thread, post and
+// history buckets, a batch per transaction so that an interrupted migration
+// resumes at the next Open. Threads are written as version 2 laid them out,
+// later migrations take them from there.
+func migrateThreads(db *bolt.DB) error {
+	err := db.Update(func(tx *bolt.Tx) (err error) {
+		for _, bucket := range [][]byte{THREAD_BUCKET, POST_BUCKET, HISTORY_BUCKET} {
+			if _, err = tx.CreateBucketIfNotExists(bucket); err != nil {
+				return
+			}
+		}
+		return
+	})
+	if err != nil {
+		return err
+	}
+	for done := false; !done; {
+		err := db.Update(func(tx *bolt.Tx) error {
+			old := tx.Bucket(BUCKET)
+			if old == nil {
+				done = true
+				return nil
+			}
+			moved := [][]byte{}
+			c := old.Cursor()
+			for k, v := c.First(); k != nil && len(moved) < migrateBatch; k, v = c.Next() {
+				thread := &stage1stpb.Thread{}
+				if err := proto.Unmarshal(v, thread); err != nil {
+					return fmt.Errorf("cannot migrate thread %s: %v", k, err)
+				}
+				if err := writeThreadV2(tx, thread); err != nil {
+					return err
+				}
+				moved = append(moved, append([]byte{}, k...))
+			}
+			if len(moved) == 0 {
+				done = true
+				return tx.DeleteBucket(BUCKET)
+			}
+			for _, k := range moved {
+				if err := old.Delete(k); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeThreadV2 stores thread in the thread, post and history buckets of a
+// database at version 2: the thread keyed by its decimal id, its posts by
+// big-endian id and floor and its visits by big-endian id, time and order.
+func writeThreadV2(tx *bolt.Tx, thread *stage1stpb.Thread) error {
+	threadId := uint32(thread.ThreadId)
+	meta := *thread
+	meta.Posts, meta.ThreadInfos = nil, nil
+	value, err := proto.Marshal(&meta)
+	if err != nil {
+		return err
+	}
+	if err := tx.Bucket(THREAD_BUCKET).Put([]byte(strconv.Itoa(int(threadId))), value); err != nil {
+		return err
+	}
+	for index, post := range thread.Posts {
+		floor := uint32(post.Floor)
+		if floor == 0 {
+			floor = uint32(index + 1)
+			// The post carries its key from now on.
+			post.Floor = int32(floor)
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint32(key, threadId)
+		binary.BigEndian.PutUint32(key[4:], floor)
+		if value, err = proto.Marshal(post); err != nil {
+			return err
+		}
+		if err := tx.Bucket(POST_BUCKET).Put(key, value); err != nil {
+			return err
+		}
+	}
+	seen := map[int64]uint32{}
+	for _, info := range thread.ThreadInfos {
+		key := make([]byte, 16)
+		binary.BigEndian.PutUint32(key, threadId)
+		binary.BigEndian.PutUint64(key[4:], uint64(info.Timestamp))
+		binary.BigEndian.PutUint32(key[12:], seen[info.Timestamp])
+		seen[info.Timestamp]++
+		if value, err = proto.Marshal(info); err != nil {
+			return err
+		}
+		if err := tx.Bucket(HISTORY_BUCKET).Put(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateThreadKeys replaces the decimal thread ids keying the thread,
+// restricted, schedule and indexed buckets by threadKey, a batch per
+// transaction.
+func migrateThreadKeys(db *bolt.DB) error {
+	for _, bucket := range [][]byte{THREAD_BUCKET, RESTRICTED_BUCKET, SCHEDULE_BUCKET, INDEXED_BUCKET} {
+		for done := false; !done; {
+			err := db.Update(func(tx *bolt.Tx) error {
+				b := tx.Bucket(bucket)
+				ids, keys, values := []int{}, [][]byte{}, [][]byte{}
+				// Decimal keys sort after the big-endian ones of any thread id
+				// below 0x30000000.
+				c := b.Cursor()
+				for k, v := c.Seek([]byte("0")); k != nil && len(ids) < migrateBatch; k, v = c.Next() {
+					if id, err := strconv.Atoi(string(k)); err == nil {
+						ids = append(ids, id)
+						keys = append(keys, append([]byte{}, k...))
+						values = append(values, append([]byte{}, v...))
+					}
+				}
+				done = len(ids) == 0
+				for i, id := range ids {
+					if err := b.Delete(keys[i]); err != nil {
+						return err
+					}
+					if err := b.Put(threadKey(id), values[i]); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// buildIndexes adds the stored threads to the index buckets, a batch per
+// transaction. The indexes are derived from the threads, so it builds the
+// current ones and runs again for each new index.
+func buildIndexes(db *bolt.DB) error {
+	err := db.Update(func(tx *bolt.Tx) (err error) {
+		for _, bucket := range indexBuckets {
+			if _, err = tx.CreateBucketIfNotExists(bucket); err != nil {
+				return
+			}
+		}
+		return
+	})
+	if err != nil {
+		return err
+	}
+	for after, done := 0, false; !done; {
+		err := db.Update(func(tx *bolt.Tx) error {
+			ids := []int{}
+			c := tx.Bucket(THREAD_BUCKET).Cursor()
+			for k, _ := c.Seek(threadKey(after + 1)); k != nil && len(ids) < migrateBatch; k, _ = c.Next() {
+				ids = append(ids, keyThreadID(k))
+			}
+			done = len(ids) == 0
+			for _, id := range ids {
+				thread, err := readThread(tx, id)
+				if err != nil {
+					return err
+				}
+				posts := storedRows(tx.Bucket(POST_BUCKET), threadKey(id))
+				if err := updateIndexes(tx, id, &stage1stpb.Thread{}, thread, posts); err != nil {
+					return err
+				}
+				after = id
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reindexSearch replaces the search index, which keyed posts by their position
+// in the thread, by one keyed by floor, a batch of threads per transaction.
+func reindexSearch(db *bolt.DB) error {
+	err := db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{SEARCH_BUCKET, INDEXED_BUCKET} {
+			if err := tx.DeleteBucket(bucket); err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+			if _, err := tx.CreateBucket(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for after, done := 0, false; !done; {
+		err := db.Update(func(tx *bolt.Tx) error {
+			ids := []int{}
+			c := tx.Bucket(THREAD_BUCKET).Cursor()
+			for k, _ := c.Seek(threadKey(after + 1)); k != nil && len(ids) < migrateBatch; k, _ = c.Next() {
+				ids = append(ids, keyThreadID(k))
+			}
+			done = len(ids) == 0
+			for _, id := range ids {
+				thread, err := readMeta(tx, id)
+				if err != nil {
+					return err
+				}
+				posts := storedRows(tx.Bucket(POST_BUCKET), threadKey(id))
+				if err := indexThread(tx, id, "", thread.Title, posts); err != nil {
+					return err
+				}
+				after = id
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
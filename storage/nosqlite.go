@@ -0,0 +1,17 @@
+//go:build !sqlite
+// +build !sqlite
+
+package storage
+
+import "errors"
+
+// SQLiteStore is a Store in a SQLite database. It needs cgo, so it's only
+// built with the sqlite build tag.
+type SQLiteStore struct {
+	Store
+}
+
+// OpenSQLite fails, SQLite support wasn't built in.
+func OpenSQLite(filename string) (*SQLiteStore, error) {
+	return nil, errors.New("storage: built without SQLite, rebuild with -tags sqlite")
+}
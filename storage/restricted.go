@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"github.com/boltdb/bolt"
+)
+
+// RESTRICTED_BUCKET maps threads we weren't allowed to read to the reason,
+// so they can be fetched again once we have logged in.
+var RESTRICTED_BUCKET = []byte("restricted")
+
+// MarkRestricted records that posts of thread threadId couldn't be read.
+func (s *Storage) MarkRestricted(threadId int, reason string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(RESTRICTED_BUCKET).Put(threadKey(threadId), []byte(reason))
+	})
+}
+
+// ClearRestricted forgets that thread threadId was restricted. Threads that
+// weren't restricted don't cost a write.
+func (s *Storage) ClearRestricted(threadId int) error {
+	restricted := false
+	s.db.View(func(tx *bolt.Tx) error {
+		restricted = tx.Bucket(RESTRICTED_BUCKET).Get(threadKey(threadId)) != nil
+		return nil
+	})
+	if !restricted {
+		return nil
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(RESTRICTED_BUCKET).Delete(threadKey(threadId))
+	})
+}
+
+// Restricted returns the restricted threads and why they are restricted.
+func (s *Storage) Restricted() (threads map[int]string, err error) {
+	threads = map[int]string{}
+	err = s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(RESTRICTED_BUCKET).ForEach(func(key, reason []byte) error {
+			threads[keyThreadID(key)] = string(reason)
+			return nil
+		})
+	})
+	return
+}
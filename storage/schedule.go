@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// SCHEDULE_BUCKET maps threads to the unix time they should be visited next.
+var SCHEDULE_BUCKET = []byte("schedule")
+
+// SetNextVisit records when thread threadId should be visited next.
+func (s *Storage) SetNextVisit(threadId int, next time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(SCHEDULE_BUCKET).Put(threadKey(threadId), []byte(fmt.Sprint(next.Unix())))
+	})
+}
+
+// NextVisit returns when thread threadId should be visited next, the zero
+// time if it has never been scheduled.
+func (s *Storage) NextVisit(threadId int) (next time.Time, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(SCHEDULE_BUCKET).Get(threadKey(threadId))
+		if value == nil {
+			return nil
+		}
+		unix, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return err
+		}
+		next = time.Unix(unix, 0)
+		return nil
+	})
+	return
+}
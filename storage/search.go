@@ -0,0 +1,416 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	"github.com/smy20011/s1go/stage1stpb"
+)
+
+var (
+	// SEARCH_BUCKET holds one nested bucket per term, mapping post keys to
+	// term frequencies. The title of a thread is indexed as floor 0.
+	SEARCH_BUCKET = []byte("search")
+	// INDEXED_BUCKET records the number of indexed posts and titles.
+	INDEXED_BUCKET = []byte("indexed")
+	totalDocsKey   = []byte("total")
+)
+
+const (
+	snippetRadius = 40
+	maxTermLength = 64
+)
+
+// SearchResult is a post matching a search query.
+type SearchResult struct {
+	ThreadID  int     `json:"thread_id"`
+	Title     string  `json:"title"`
+	PostIndex int     `json:"post_index"`
+	Floor     int     `json:"floor"`
+	Author    string  `json:"author"`
+	PostTime  int64   `json:"post_time"`
+	Score     float64 `json:"score"`
+	Snippet   string  `json:"snippet"`
+}
+
+// Search returns at most limit posts containing every term of query, ordered
+// by relevance. The postings of the rarest term are intersected with the
+// others in key order until limit posts match, so a query of common terms
+// doesn't read the whole index.
+func (s *Storage) Search(query string, limit int) (results []SearchResult, err error) {
+	terms := uniqueTerms(tokenize(query))
+	if len(terms) == 0 {
+		return
+	}
+	err = s.db.View(func(tx *bolt.Tx) error {
+		index := tx.Bucket(SEARCH_BUCKET)
+		total := float64(readUint64(tx.Bucket(INDEXED_BUCKET).Get(totalDocsKey)))
+		postings, idfs := make([]*bolt.Bucket, len(terms)), make([]float64, len(terms))
+		for i, term := range terms {
+			if postings[i] = index.Bucket([]byte(term)); postings[i] == nil {
+				return nil
+			}
+			idfs[i] = math.Log(1 + total/float64(postings[i].Stats().KeyN))
+		}
+		sort.Sort(byRarity{postings, idfs})
+
+		// Titles count as part of the first post of their thread.
+		firstFloors := map[int]int{}
+		first := func(threadID int) int {
+			floor, found := firstFloors[threadID]
+			if !found {
+				floor = firstFloor(tx, threadID)
+				firstFloors[threadID] = floor
+			}
+			return floor
+		}
+		frequency := func(postings *bolt.Bucket, threadID, floor int) (tf uint32) {
+			if value := postings.Get(postKey(threadID, floor)); value != nil {
+				tf += binary.BigEndian.Uint32(value)
+			}
+			if floor == first(threadID) {
+				if value := postings.Get(postKey(threadID, 0)); value != nil {
+					tf += binary.BigEndian.Uint32(value)
+				}
+			}
+			return
+		}
+
+		titles, seen := map[int]string{}, map[string]bool{}
+		c := postings[0].Cursor()
+		for key, _ := c.First(); key != nil && (limit <= 0 || len(results) < limit); key, _ = c.Next() {
+			threadID, floor := keyThreadID(key), int(binary.BigEndian.Uint32(key[4:]))
+			if floor == 0 {
+				if floor = first(threadID); floor == 0 {
+					continue
+				}
+			}
+			doc := postKey(threadID, floor)
+			if seen[string(doc)] {
+				continue
+			}
+			seen[string(doc)] = true
+			score := 0.0
+			for i := range postings {
+				tf := frequency(postings[i], threadID, floor)
+				if tf == 0 {
+					score = 0
+					break
+				}
+				score += (1 + math.Log(float64(tf))) * idfs[i]
+			}
+			if score == 0 {
+				continue
+			}
+			result, err := readResult(tx, doc, titles, terms)
+			if err != nil {
+				return err
+			}
+			result.Score = score
+			results = append(results, result)
+		}
+		return nil
+	})
+	sortResults(results)
+	return
+}
+
+// byRarity sorts postings and their idfs, the rarest term first.
+type byRarity struct {
+	postings []*bolt.Bucket
+	idfs     []float64
+}
+
+func (b byRarity) Len() int { return len(b.postings) }
+
+func (b byRarity) Less(i, j int) bool {
+	return b.idfs[i] > b.idfs[j]
+}
+
+func (b byRarity) Swap(i, j int) {
+	b.postings[i], b.postings[j] = b.postings[j], b.postings[i]
+	b.idfs[i], b.idfs[j] = b.idfs[j], b.idfs[i]
+}
+
+// readResult reads the post at doc, a post key, as a result matching terms.
+// The titles of threads are cached in titles.
+func readResult(tx *bolt.Tx, doc []byte, titles map[int]string, terms []string) (SearchResult, error) {
+	threadID, floor := keyThreadID(doc), int(binary.BigEndian.Uint32(doc[4:]))
+	result := SearchResult{ThreadID: threadID, Floor: floor}
+	title, found := titles[threadID]
+	if !found {
+		meta, err := readMeta(tx, threadID)
+		if err != nil {
+			return result, err
+		}
+		title, titles[threadID] = meta.Title, meta.Title
+	}
+	post := &stage1stpb.Post{}
+	if err := proto.Unmarshal(tx.Bucket(POST_BUCKET).Get(doc), post); err != nil {
+		return result, err
+	}
+	// The position of the post is the number of posts before it.
+	prefix := threadKey(threadID)
+	c := tx.Bucket(POST_BUCKET).Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.Compare(k, doc) < 0; k, _ = c.Next() {
+		result.PostIndex++
+	}
+	result.Title, result.Author, result.PostTime = title, post.Author, post.PostTime
+	result.Snippet = snippet(post.Content, terms)
+	return result, nil
+}
+
+// sortResults orders results by score, then newest first.
+func sortResults(results []SearchResult) {
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].PostTime > results[j].PostTime
+	})
+}
+
+// indexThread updates the search index of thread threadId after its title
+// changed from oldTitle to title and its posts changed.
+func indexThread(tx *bolt.Tx, threadId int, oldTitle, title string, posts []rowChange) error {
+	indexed := tx.Bucket(INDEXED_BUCKET)
+	total := readUint64(indexed.Get(totalDocsKey))
+	update := func(doc []byte, before, after string) error {
+		if before == after {
+			return nil
+		}
+		if len(before) > 0 {
+			if err := unindexDoc(tx, doc, before); err != nil {
+				return err
+			}
+			if total > 0 {
+				total--
+			}
+		}
+		if len(after) > 0 {
+			if err := indexDoc(tx, doc, after); err != nil {
+				return err
+			}
+			total++
+		}
+		return nil
+	}
+
+	if err := update(postKey(threadId, 0), oldTitle, title); err != nil {
+		return err
+	}
+	for _, change := range posts {
+		before, after := &stage1stpb.Post{}, &stage1stpb.Post{}
+		if err := proto.Unmarshal(change.before, before); err != nil {
+			return err
+		}
+		if err := proto.Unmarshal(change.after, after); err != nil {
+			return err
+		}
+		if err := update(change.key, before.Content, after.Content); err != nil {
+			return err
+		}
+	}
+	return indexed.Put(totalDocsKey, encodeUint64(total))
+}
+
+// indexDoc adds the terms of text to the postings of doc.
+func indexDoc(tx *bolt.Tx, doc []byte, text string) error {
+	index := tx.Bucket(SEARCH_BUCKET)
+	for term, tf := range termFrequencies(text) {
+		postings, err := index.CreateBucketIfNotExists([]byte(term))
+		if err != nil {
+			return err
+		}
+		value := make([]byte, 4)
+		binary.BigEndian.PutUint32(value, tf)
+		if err := postings.Put(doc, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unindexDoc removes doc from the postings of the terms of text, what it was
+// indexed with.
+func unindexDoc(tx *bolt.Tx, doc []byte, text string) error {
+	index := tx.Bucket(SEARCH_BUCKET)
+	for _, term := range uniqueTerms(tokenize(text)) {
+		if postings := index.Bucket([]byte(term)); postings != nil {
+			if err := postings.Delete(doc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// termFrequencies counts the terms of text.
+func termFrequencies(text string) map[string]uint32 {
+	frequencies := map[string]uint32{}
+	for _, term := range tokenize(text) {
+		frequencies[term]++
+	}
+	return frequencies
+}
+
+// firstFloor returns the floor of the first stored post of thread threadId.
+func firstFloor(tx *bolt.Tx, threadId int) int {
+	prefix := threadKey(threadId)
+	k, _ := tx.Bucket(POST_BUCKET).Cursor().Seek(prefix)
+	if k == nil || !bytes.HasPrefix(k, prefix) {
+		return 0
+	}
+	return int(binary.BigEndian.Uint32(k[4:]))
+}
+
+// tokenize splits text into lower-cased latin words, and CJK characters and
+// their overlapping bigrams, since Chinese text has no spaces between words.
+func tokenize(text string) (terms []string) {
+	var word, cjk []rune
+	flush := func() {
+		if len(word) > 0 && len(string(word)) <= maxTermLength {
+			terms = append(terms, string(word))
+		}
+		word = word[:0]
+		for i := range cjk {
+			terms = append(terms, string(cjk[i]))
+			if i+1 < len(cjk) {
+				terms = append(terms, string(cjk[i:i+2]))
+			}
+		}
+		cjk = cjk[:0]
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			if len(word) > 0 {
+				flush()
+			}
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if len(cjk) > 0 {
+				flush()
+			}
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+func uniqueTerms(terms []string) (result []string) {
+	seen := map[string]bool{}
+	for _, term := range terms {
+		if !seen[term] {
+			seen[term] = true
+			result = append(result, term)
+		}
+	}
+	return
+}
+
+// snippet returns the part of content around the first occurrence of any term.
+func snippet(content string, terms []string) string {
+	runes := []rune(content)
+	lower := []rune(strings.ToLower(content))
+	match := -1
+	for _, term := range terms {
+		if i := runeIndex(lower, []rune(term)); i >= 0 && (match < 0 || i < match) {
+			match = i
+		}
+	}
+	if match < 0 {
+		match = 0
+	}
+	start, end := match-snippetRadius, match+snippetRadius
+	prefix, suffix := "…", "…"
+	if start <= 0 {
+		start, prefix = 0, ""
+	}
+	if end >= len(runes) {
+		end, suffix = len(runes), ""
+	}
+	return prefix + strings.TrimSpace(string(runes[start:end])) + suffix
+}
+
+func runeIndex(s, sub []rune) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if string(s[i:i+len(sub)]) == string(sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+func encodeUint64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func readUint64(b []byte) uint64 {
+	if len(b) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// scanSearch is Search for stores without an index, it reads every post of
+// store.
+func scanSearch(store Store, query string, limit int) (results []SearchResult, err error) {
+	terms := uniqueTerms(tokenize(query))
+	if len(terms) == 0 {
+		return
+	}
+	err = store.Scan(func(thread *stage1stpb.Thread) error {
+		for i, post := range thread.Posts {
+			text := post.Content
+			if i == 0 {
+				text = thread.Title + "\n" + text
+			}
+			frequencies := map[string]int{}
+			for _, term := range tokenize(text) {
+				frequencies[term]++
+			}
+			score := 0.0
+			for _, term := range terms {
+				if frequencies[term] == 0 {
+					score = 0
+					break
+				}
+				score += 1 + math.Log(float64(frequencies[term]))
+			}
+			if score > 0 {
+				results = append(results, SearchResult{
+					ThreadID:  int(thread.ThreadId),
+					Title:     thread.Title,
+					PostIndex: i,
+					Floor:     PostFloor(i, post),
+					Author:    post.Author,
+					PostTime:  post.PostTime,
+					Score:     score,
+					Snippet:   snippet(post.Content, terms),
+				})
+			}
+		}
+		return nil
+	})
+	sortResults(results)
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return
+}
@@ -0,0 +1,730 @@
+//go:build sqlite
+// +build sqlite
+
+package storage
+
+import (
+	"bytes"
+	"database/sql"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/smy20011/s1go/stage1stpb"
+)
+
+// sqliteSchema lays threads out in plain tables, so the archive can be
+// queried with SQL. Posts keep their rich content, ratings and revisions in
+// data, the encoded stage1stpb.Post. terms is the search index like
+// SEARCH_BUCKET, mapping the terms of posts to their frequencies, with the
+// title of a thread at floor 0.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS threads (
+	thread_id INTEGER PRIMARY KEY,
+	forum_id INTEGER NOT NULL,
+	title TEXT NOT NULL,
+	author TEXT NOT NULL,
+	author_id INTEGER NOT NULL,
+	sticky INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS threads_forum ON threads (forum_id, thread_id);
+CREATE TABLE IF NOT EXISTS posts (
+	thread_id INTEGER NOT NULL,
+	floor INTEGER NOT NULL,
+	post_id INTEGER NOT NULL,
+	author TEXT NOT NULL,
+	author_id INTEGER NOT NULL,
+	post_time INTEGER NOT NULL,
+	content TEXT NOT NULL,
+	deleted INTEGER NOT NULL,
+	data BLOB NOT NULL,
+	PRIMARY KEY (thread_id, floor)
+);
+CREATE INDEX IF NOT EXISTS posts_author ON posts (author, post_time);
+CREATE INDEX IF NOT EXISTS posts_time ON posts (post_time);
+CREATE TABLE IF NOT EXISTS snapshots (
+	thread_id INTEGER NOT NULL,
+	timestamp INTEGER NOT NULL,
+	seq INTEGER NOT NULL,
+	rank INTEGER NOT NULL,
+	replies INTEGER NOT NULL,
+	PRIMARY KEY (thread_id, timestamp, seq)
+);
+CREATE TABLE IF NOT EXISTS restricted (
+	thread_id INTEGER PRIMARY KEY,
+	reason TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS failures (
+	thread_id INTEGER PRIMARY KEY,
+	kind TEXT NOT NULL,
+	time INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS schedule (
+	thread_id INTEGER PRIMARY KEY,
+	next_visit INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS terms (
+	term TEXT NOT NULL,
+	thread_id INTEGER NOT NULL,
+	floor INTEGER NOT NULL,
+	tf INTEGER NOT NULL,
+	PRIMARY KEY (term, thread_id, floor)
+) WITHOUT ROWID;
+CREATE INDEX IF NOT EXISTS terms_doc ON terms (thread_id, floor);
+CREATE TABLE IF NOT EXISTS backfill (
+	cursor TEXT PRIMARY KEY,
+	page INTEGER NOT NULL
+);
+`
+
+// SQLiteStore is a Store in a SQLite database. It needs cgo, so it's only
+// built with the sqlite build tag.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+var _ Store = &SQLiteStore{}
+
+// OpenSQLite opens the SQLite database in filename, creating it if needed.
+// Databases written before posts were indexed for search are indexed first.
+func OpenSQLite(filename string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", filename+"?_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+	// Writes are serialized by SQLite anyway.
+	db.SetMaxOpenConns(1)
+	store := &SQLiteStore{db}
+	var indexed int
+	err = db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'terms'").Scan(&indexed)
+	if err == nil {
+		_, err = db.Exec(sqliteSchema)
+	}
+	if err == nil && indexed == 0 {
+		err = store.indexAll()
+	}
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// indexAll indexes the titles and posts of every thread for search, a thread
+// per transaction.
+func (s *SQLiteStore) indexAll() error {
+	ids, err := s.ListThreadIDs()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		thread := &stage1stpb.Thread{}
+		if err := s.db.QueryRow("SELECT title FROM threads WHERE thread_id = ?", id).Scan(&thread.Title); err != nil {
+			return err
+		}
+		rows, err := s.db.Query("SELECT floor, content FROM posts WHERE thread_id = ?", id)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			post := &stage1stpb.Post{}
+			if err := rows.Scan(&post.Floor, &post.Content); err != nil {
+				rows.Close()
+				return err
+			}
+			thread.Posts = append(thread.Posts, post)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		tx, err := s.db.Begin()
+		if err != nil {
+			return err
+		}
+		err = indexText(tx, id, 0, thread.Title)
+		for _, post := range thread.Posts {
+			if err == nil {
+				err = indexText(tx, id, int(post.Floor), post.Content)
+			}
+		}
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexText replaces the terms of the post at floor of thread threadId, or
+// its title at floor 0, by the terms of text.
+func indexText(tx *sql.Tx, threadId, floor int, text string) error {
+	if _, err := tx.Exec("DELETE FROM terms WHERE thread_id = ? AND floor = ?", threadId, floor); err != nil {
+		return err
+	}
+	for term, tf := range termFrequencies(text) {
+		if _, err := tx.Exec("INSERT INTO terms (term, thread_id, floor, tf) VALUES (?, ?, ?, ?)", term, threadId, floor, tf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SQLiteStore) Get(threadId int) (*stage1stpb.Thread, error) {
+	thread := &stage1stpb.Thread{}
+	err := s.db.QueryRow(
+		"SELECT thread_id, forum_id, title, author, author_id, sticky FROM threads WHERE thread_id = ?", threadId,
+	).Scan(&thread.ThreadId, &thread.ForumId, &thread.Title, &thread.Author, &thread.AuthorId, &thread.Sticky)
+	if err == sql.ErrNoRows {
+		return thread, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if thread.Posts, err = s.Posts(threadId); err != nil {
+		return nil, err
+	}
+	if thread.ThreadInfos, err = s.Snapshots(threadId); err != nil {
+		return nil, err
+	}
+	return thread, nil
+}
+
+func (s *SQLiteStore) Put(thread *stage1stpb.Thread) (err error) {
+	if err := checkFloors(thread); err != nil {
+		return err
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+	var oldTitle string
+	err = tx.QueryRow("SELECT title FROM threads WHERE thread_id = ?", thread.ThreadId).Scan(&oldTitle)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	_, err = tx.Exec(
+		"INSERT OR REPLACE INTO threads (thread_id, forum_id, title, author, author_id, sticky) VALUES (?, ?, ?, ?, ?, ?)",
+		thread.ThreadId, thread.ForumId, thread.Title, thread.Author, thread.AuthorId, thread.Sticky)
+	if err != nil {
+		return err
+	}
+	if oldTitle != thread.Title {
+		if err = indexText(tx, int(thread.ThreadId), 0, thread.Title); err != nil {
+			return err
+		}
+	}
+	if err = replacePosts(tx, thread); err != nil {
+		return err
+	}
+	return replaceSnapshots(tx, thread)
+}
+
+// replacePosts makes the posts of thread its stored posts, writing only the
+// rows that changed like replaceRows. Posts whose content changed are indexed
+// again.
+func replacePosts(tx *sql.Tx, thread *stage1stpb.Thread) error {
+	rows, err := tx.Query("SELECT floor, data FROM posts WHERE thread_id = ?", thread.ThreadId)
+	if err != nil {
+		return err
+	}
+	stored := map[int][]byte{}
+	for rows.Next() {
+		var floor int
+		var data []byte
+		if err := rows.Scan(&floor, &data); err != nil {
+			rows.Close()
+			return err
+		}
+		stored[floor] = data
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for index, post := range thread.Posts {
+		floor := PostFloor(index, post)
+		data, err := proto.Marshal(post)
+		if err != nil {
+			return err
+		}
+		old, ok := stored[floor]
+		delete(stored, floor)
+		if ok && bytes.Equal(old, data) {
+			continue
+		}
+		_, err = tx.Exec(
+			"INSERT OR REPLACE INTO posts (thread_id, floor, post_id, author, author_id, post_time, content, deleted, data) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			thread.ThreadId, floor, post.PostId, post.Author, post.AuthorId, post.PostTime, post.Content, post.Deleted, data)
+		if err != nil {
+			return err
+		}
+		oldPost := &stage1stpb.Post{}
+		if err := proto.Unmarshal(old, oldPost); err != nil {
+			return err
+		}
+		if !ok || oldPost.Content != post.Content {
+			if err := indexText(tx, int(thread.ThreadId), floor, post.Content); err != nil {
+				return err
+			}
+		}
+	}
+	for floor := range stored {
+		if _, err := tx.Exec("DELETE FROM posts WHERE thread_id = ? AND floor = ?", thread.ThreadId, floor); err != nil {
+			return err
+		}
+		if err := indexText(tx, int(thread.ThreadId), floor, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotKey is the key of a row of snapshots of a thread.
+type snapshotKey struct {
+	timestamp int64
+	seq       int
+}
+
+// replaceSnapshots makes the visits of thread its stored snapshots, writing
+// only the rows that changed.
+func replaceSnapshots(tx *sql.Tx, thread *stage1stpb.Thread) error {
+	rows, err := tx.Query("SELECT timestamp, seq, rank, replies FROM snapshots WHERE thread_id = ?", thread.ThreadId)
+	if err != nil {
+		return err
+	}
+	stored := map[snapshotKey]stage1stpb.ThreadInfo{}
+	for rows.Next() {
+		var key snapshotKey
+		var info stage1stpb.ThreadInfo
+		if err := rows.Scan(&key.timestamp, &key.seq, &info.Rank, &info.Replies); err != nil {
+			rows.Close()
+			return err
+		}
+		stored[key] = info
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	seen := map[int64]int{}
+	for _, info := range thread.ThreadInfos {
+		key := snapshotKey{info.Timestamp, seen[info.Timestamp]}
+		seen[info.Timestamp]++
+		old, ok := stored[key]
+		delete(stored, key)
+		if ok && old.Rank == info.Rank && old.Replies == info.Replies {
+			continue
+		}
+		_, err = tx.Exec(
+			"INSERT OR REPLACE INTO snapshots (thread_id, timestamp, seq, rank, replies) VALUES (?, ?, ?, ?, ?)",
+			thread.ThreadId, key.timestamp, key.seq, info.Rank, info.Replies)
+		if err != nil {
+			return err
+		}
+	}
+	for key := range stored {
+		_, err := tx.Exec("DELETE FROM snapshots WHERE thread_id = ? AND timestamp = ? AND seq = ?", thread.ThreadId, key.timestamp, key.seq)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SQLiteStore) Delete(threadId int) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+	return deleteRows(tx, threadId, "threads", "posts", "terms", "snapshots", "restricted", "failures", "schedule")
+}
+
+// deleteRows deletes the rows of thread threadId from tables.
+func deleteRows(tx *sql.Tx, threadId int, tables ...string) error {
+	for _, table := range tables {
+		if _, err := tx.Exec("DELETE FROM "+table+" WHERE thread_id = ?", threadId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SQLiteStore) ListThreadIDs() ([]int, error) {
+	return s.ListThreadIDsAfter(0, 0)
+}
+
+func (s *SQLiteStore) ListThreadIDsAfter(after, limit int) ([]int, error) {
+	// A negative LIMIT has no limit.
+	if limit <= 0 {
+		limit = -1
+	}
+	return s.queryThreadIDs("SELECT thread_id FROM threads WHERE thread_id > ? ORDER BY thread_id LIMIT ?", after, limit)
+}
+
+func (s *SQLiteStore) ListByForum(forumId, after, limit int) ([]int, error) {
+	if limit <= 0 {
+		limit = -1
+	}
+	return s.queryThreadIDs("SELECT thread_id FROM threads WHERE forum_id = ? AND thread_id > ? ORDER BY thread_id LIMIT ?", forumId, after, limit)
+}
+
+// queryThreadIDs returns the thread ids selected by query.
+func (s *SQLiteStore) queryThreadIDs(query string, args ...interface{}) (ids []int, err error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (s *SQLiteStore) Scan(fn func(*stage1stpb.Thread) error) error {
+	return s.ScanAfter(0, fn)
+}
+
+func (s *SQLiteStore) ScanAfter(after int, fn func(*stage1stpb.Thread) error) error {
+	ids, err := s.ListThreadIDsAfter(after, 0)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		thread, err := s.Get(id)
+		if err != nil {
+			return err
+		}
+		if thread.ThreadId == 0 {
+			// Deleted while scanning.
+			continue
+		}
+		if err := fn(thread); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SQLiteStore) Posts(threadId int) (posts []*stage1stpb.Post, err error) {
+	rows, err := s.db.Query("SELECT data FROM posts WHERE thread_id = ? ORDER BY floor", threadId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		post := &stage1stpb.Post{}
+		if err := proto.Unmarshal(data, post); err != nil {
+			return nil, err
+		}
+		posts = append(posts, post)
+	}
+	return posts, rows.Err()
+}
+
+func (s *SQLiteStore) Snapshots(threadId int) (infos []*stage1stpb.ThreadInfo, err error) {
+	rows, err := s.db.Query("SELECT rank, replies, timestamp FROM snapshots WHERE thread_id = ? ORDER BY timestamp, seq", threadId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		info := &stage1stpb.ThreadInfo{}
+		if err := rows.Scan(&info.Rank, &info.Replies, &info.Timestamp); err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, rows.Err()
+}
+
+func (s *SQLiteStore) LastSnapshot(threadId int) (*stage1stpb.ThreadInfo, error) {
+	info := &stage1stpb.ThreadInfo{}
+	err := s.db.QueryRow(
+		"SELECT rank, replies, timestamp FROM snapshots WHERE thread_id = ? ORDER BY timestamp DESC, seq DESC LIMIT 1", threadId,
+	).Scan(&info.Rank, &info.Replies, &info.Timestamp)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// Search matches the terms of query in the content of posts, and the title of
+// threads with their first post, like Storage.Search.
+func (s *SQLiteStore) Search(query string, limit int) (results []SearchResult, err error) {
+	terms := uniqueTerms(tokenize(query))
+	if len(terms) == 0 {
+		return
+	}
+	args := []interface{}{}
+	for _, term := range terms {
+		args = append(args, term)
+	}
+	in := "(?" + strings.Repeat(", ?", len(terms)-1) + ")"
+
+	// Terms are scored by tf-idf like in Storage.Search.
+	var total float64
+	err = s.db.QueryRow(`SELECT (SELECT COUNT(*) FROM posts WHERE content != '') + (SELECT COUNT(*) FROM threads WHERE title != '')`).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+	idfs := map[string]float64{}
+	rows, err := s.db.Query("SELECT term, COUNT(*) FROM terms WHERE term IN "+in+" GROUP BY term", args...)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var term string
+		var df float64
+		if err := rows.Scan(&term, &df); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		idfs[term] = math.Log(1 + total/df)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(idfs) < len(terms) {
+		return
+	}
+
+	// Titles count as part of the first post of their thread.
+	if limit <= 0 {
+		limit = -1
+	}
+	rows, err = s.db.Query(`
+		WITH hits AS (
+			SELECT term, thread_id, tf, CASE floor
+				WHEN 0 THEN (SELECT MIN(p.floor) FROM posts p WHERE p.thread_id = terms.thread_id)
+				ELSE floor END AS floor
+			FROM terms WHERE term IN `+in+`
+		), docs AS (
+			SELECT thread_id, floor FROM hits GROUP BY thread_id, floor
+			HAVING COUNT(DISTINCT term) = ? LIMIT ?
+		)
+		SELECT term, thread_id, floor, SUM(tf) FROM hits JOIN docs USING (thread_id, floor)
+		GROUP BY term, thread_id, floor`, append(args, len(terms), limit)...)
+	if err != nil {
+		return nil, err
+	}
+	type doc struct{ threadId, floor int }
+	scores, docs := map[doc]float64{}, []doc{}
+	for rows.Next() {
+		var term string
+		var d doc
+		var tf float64
+		if err := rows.Scan(&term, &d.threadId, &d.floor, &tf); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if _, found := scores[d]; !found {
+			docs = append(docs, d)
+		}
+		scores[d] += (1 + math.Log(tf)) * idfs[term]
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, d := range docs {
+		result, content := SearchResult{ThreadID: d.threadId, Floor: d.floor, Score: scores[d]}, ""
+		err := s.db.QueryRow(`
+			SELECT t.title, p.author, p.post_time, p.content,
+				(SELECT COUNT(*) FROM posts q WHERE q.thread_id = p.thread_id AND q.floor < p.floor)
+			FROM posts p JOIN threads t ON t.thread_id = p.thread_id
+			WHERE p.thread_id = ? AND p.floor = ?`, d.threadId, d.floor,
+		).Scan(&result.Title, &result.Author, &result.PostTime, &content, &result.PostIndex)
+		if err != nil {
+			return nil, err
+		}
+		result.Snippet = snippet(content, terms)
+		results = append(results, result)
+	}
+	sortResults(results)
+	return
+}
+
+func (s *SQLiteStore) RecentThreads(forumId, limit int) ([]int, error) {
+	if limit <= 0 {
+		limit = -1
+	}
+	return s.queryThreadIDs(`
+		SELECT thread_id FROM threads t WHERE ? = 0 OR forum_id = ?
+		ORDER BY (SELECT COALESCE(MAX(post_time), 0) FROM posts p WHERE p.thread_id = t.thread_id) DESC, thread_id DESC
+		LIMIT ?`, forumId, forumId, limit)
+}
+
+func (s *SQLiteStore) PostsByAuthor(author string, from, to time.Time) ([]PostRef, error) {
+	return s.queryPosts(`
+		SELECT thread_id, floor, data FROM posts WHERE author = ? AND post_time >= ? AND post_time < ?
+		ORDER BY post_time, thread_id, floor`, author, from.Unix(), to.Unix())
+}
+
+func (s *SQLiteStore) PostsBetween(from, to time.Time) ([]PostRef, error) {
+	return s.queryPosts(`
+		SELECT thread_id, floor, data FROM posts WHERE post_time >= ? AND post_time < ?
+		ORDER BY post_time, thread_id, floor`, from.Unix(), to.Unix())
+}
+
+// queryPosts returns the thread ids, floors and data of posts selected by
+// query.
+func (s *SQLiteStore) queryPosts(query string, args ...interface{}) (posts []PostRef, err error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		ref, data := PostRef{Post: &stage1stpb.Post{}}, []byte{}
+		if err := rows.Scan(&ref.ThreadID, &ref.Floor, &data); err != nil {
+			return nil, err
+		}
+		if err := proto.Unmarshal(data, ref.Post); err != nil {
+			return nil, err
+		}
+		posts = append(posts, ref)
+	}
+	return posts, rows.Err()
+}
+
+func (s *SQLiteStore) MarkRestricted(threadId int, reason string) error {
+	_, err := s.db.Exec("INSERT OR REPLACE INTO restricted (thread_id, reason) VALUES (?, ?)", threadId, reason)
+	return err
+}
+
+func (s *SQLiteStore) ClearRestricted(threadId int) error {
+	var restricted int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM restricted WHERE thread_id = ?", threadId).Scan(&restricted)
+	if err != nil || restricted == 0 {
+		return err
+	}
+	_, err = s.db.Exec("DELETE FROM restricted WHERE thread_id = ?", threadId)
+	return err
+}
+
+func (s *SQLiteStore) Restricted() (map[int]string, error) {
+	rows, err := s.db.Query("SELECT thread_id, reason FROM restricted")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	threads := map[int]string{}
+	for rows.Next() {
+		var id int
+		var reason string
+		if err := rows.Scan(&id, &reason); err != nil {
+			return nil, err
+		}
+		threads[id] = reason
+	}
+	return threads, rows.Err()
+}
+
+func (s *SQLiteStore) RecordFailure(threadId int, failure Failure) error {
+	_, err := s.db.Exec("INSERT OR REPLACE INTO failures (thread_id, kind, time) VALUES (?, ?, ?)", threadId, failure.Kind, failure.Time.Unix())
+	return err
+}
+
+func (s *SQLiteStore) ClearFailure(threadId int) error {
+	var failed int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM failures WHERE thread_id = ?", threadId).Scan(&failed)
+	if err != nil || failed == 0 {
+		return err
+	}
+	_, err = s.db.Exec("DELETE FROM failures WHERE thread_id = ?", threadId)
+	return err
+}
+
+func (s *SQLiteStore) Failures() (map[int]Failure, error) {
+	rows, err := s.db.Query("SELECT thread_id, kind, time FROM failures")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	failures := map[int]Failure{}
+	for rows.Next() {
+		var id int
+		var unix int64
+		failure := Failure{}
+		if err := rows.Scan(&id, &failure.Kind, &unix); err != nil {
+			return nil, err
+		}
+		failure.Time = time.Unix(unix, 0)
+		failures[id] = failure
+	}
+	return failures, rows.Err()
+}
+
+func (s *SQLiteStore) SetNextVisit(threadId int, next time.Time) error {
+	_, err := s.db.Exec("INSERT OR REPLACE INTO schedule (thread_id, next_visit) VALUES (?, ?)", threadId, next.Unix())
+	return err
+}
+
+func (s *SQLiteStore) NextVisit(threadId int) (time.Time, error) {
+	var unix int64
+	err := s.db.QueryRow("SELECT next_visit FROM schedule WHERE thread_id = ?", threadId).Scan(&unix)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(unix, 0), nil
+}
+
+func (s *SQLiteStore) BackfillCursor(cursor string) (page int, err error) {
+	err = s.db.QueryRow("SELECT page FROM backfill WHERE cursor = ?", cursor).Scan(&page)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return
+}
+
+func (s *SQLiteStore) SetBackfillCursor(cursor string, page int) error {
+	_, err := s.db.Exec("INSERT OR REPLACE INTO backfill (cursor, page) VALUES (?, ?)", cursor, page)
+	return err
+}
+
+func (s *SQLiteStore) ClearBackfillCursor(cursor string) error {
+	_, err := s.db.Exec("DELETE FROM backfill WHERE cursor = ?", cursor)
+	return err
+}
+
+func (s *SQLiteStore) Sync() error {
+	return nil
+}
+
+func (s *SQLiteStore) Close() {
+	s.db.Close()
+}
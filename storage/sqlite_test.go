@@ -0,0 +1,85 @@
+//go:build sqlite
+// +build sqlite
+
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/smy20011/s1go/stage1stpb"
+)
+
+func TestSQLiteStore(t *testing.T) {
+	store, err := OpenSQLite(filepath.Join(tmpDir, "store.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	testStore(t, store)
+}
+
+func TestSQLiteStore_Search(t *testing.T) {
+	file := filepath.Join(tmpDir, "search.sqlite")
+	store, err := OpenSQLite(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSearch(t, store)
+
+	// Databases written before the index are indexed when opened.
+	if _, err := store.db.Exec("DROP TABLE terms"); err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+	if store, err = OpenSQLite(file); err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	if results, err := store.Search("3dsll", 10); err != nil || len(results) != 2 {
+		t.Fatalf("Expected the stored posts indexed, got %v, %v", results, err)
+	}
+}
+
+func TestSQLiteStore_Put(t *testing.T) {
+	store, err := OpenSQLite(filepath.Join(tmpDir, "put.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	thread := &stage1stpb.Thread{
+		ThreadId: 1,
+		Posts: []*stage1stpb.Post{
+			{Floor: 1, Content: "first"},
+			{Floor: 2, Content: "second"},
+			{Floor: 3, Content: "third"},
+		},
+		ThreadInfos: []*stage1stpb.ThreadInfo{{Timestamp: 1, Replies: 2}, {Timestamp: 1, Replies: 3}},
+	}
+	if err := store.Put(thread); err != nil {
+		t.Fatal(err)
+	}
+	// Rows that don't change aren't written again.
+	if _, err := store.db.Exec("UPDATE posts SET author = 'untouched' WHERE floor = 1"); err != nil {
+		t.Fatal(err)
+	}
+	thread.Posts = []*stage1stpb.Post{thread.Posts[0], {Floor: 3, Content: "third, edited"}}
+	thread.ThreadInfos = thread.ThreadInfos[1:]
+	if err := store.Put(thread); err != nil {
+		t.Fatal(err)
+	}
+	var author string
+	if err := store.db.QueryRow("SELECT author FROM posts WHERE floor = 1").Scan(&author); err != nil || author != "untouched" {
+		t.Fatalf("Expected the unchanged post untouched, got %v, %v", author, err)
+	}
+	saved, err := store.Get(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.Posts) != 2 || saved.Posts[1].Content != "third, edited" {
+		t.Fatalf("Expected the second post deleted and the third edited, got %v", saved.Posts)
+	}
+	if len(saved.ThreadInfos) != 1 || saved.ThreadInfos[0].Replies != 3 {
+		t.Fatalf("Expected the remaining visit, got %v", saved.ThreadInfos)
+	}
+}
@@ -1,56 +1,399 @@
 package storage
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"sort"
+
 	"github.com/boltdb/bolt"
 	"github.com/golang/protobuf/proto"
 	"github.com/smy20011/s1go/stage1stpb"
 )
 
-var BUCKET = []byte("stage1st")
+var (
+	// BUCKET holds whole threads as written by older versions, Open moves
+	// them to the buckets below.
+	BUCKET = []byte("stage1st")
+	// THREAD_BUCKET maps thread ids to threads without their posts and
+	// visits.
+	THREAD_BUCKET = []byte("threads")
+	// POST_BUCKET maps thread id and floor to posts.
+	POST_BUCKET = []byte("posts")
+	// HISTORY_BUCKET maps thread id and time to the rank and replies of the
+	// thread seen at that time.
+	HISTORY_BUCKET = []byte("history")
+)
 
+// Storage is a Store in a BoltDB file.
 type Storage struct {
 	db *bolt.DB
 }
 
+var _ Store = &Storage{}
+
 func (s *Storage) Get(threadId int) (thread *stage1stpb.Thread, err error) {
-	thread = &stage1stpb.Thread{}
-	err = s.db.View(func(tx *bolt.Tx) error {
-		key := []byte(fmt.Sprint(threadId))
-		bytes := tx.Bucket(BUCKET).Get(key)
-		return proto.Unmarshal(bytes, thread)
+	err = s.db.View(func(tx *bolt.Tx) (err error) {
+		thread, err = readThread(tx, threadId)
+		return
 	})
 	return
 }
 
 func (s *Storage) Put(thread *stage1stpb.Thread) (err error) {
 	return s.db.Update(func(tx *bolt.Tx) error {
-		key := []byte(fmt.Sprint(thread.ThreadId))
-		bytes, err := proto.Marshal(thread)
+		old, posts, err := writeThread(tx, thread)
 		if err != nil {
 			return err
 		}
-		return tx.Bucket(BUCKET).Put(key, bytes)
+		if err := updateIndexes(tx, int(thread.ThreadId), old, thread, posts); err != nil {
+			return err
+		}
+		return indexThread(tx, int(thread.ThreadId), old.Title, thread.Title, posts)
 	})
 }
 
+func (s *Storage) Delete(threadId int) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		old, err := readMeta(tx, threadId)
+		if err != nil {
+			return err
+		}
+		key := threadKey(threadId)
+		posts, err := replaceRows(tx.Bucket(POST_BUCKET), key, nil)
+		if err != nil {
+			return err
+		}
+		if err := updateIndexes(tx, threadId, old, nil, posts); err != nil {
+			return err
+		}
+		if err := indexThread(tx, threadId, old.Title, "", posts); err != nil {
+			return err
+		}
+		if _, err := replaceRows(tx.Bucket(HISTORY_BUCKET), key, nil); err != nil {
+			return err
+		}
+		for _, bucket := range [][]byte{THREAD_BUCKET, RESTRICTED_BUCKET, FAILURES_BUCKET, SCHEDULE_BUCKET} {
+			if err := tx.Bucket(bucket).Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Storage) ListThreadIDs() ([]int, error) {
+	return s.ListThreadIDsAfter(0, 0)
+}
+
+func (s *Storage) ListThreadIDsAfter(after, limit int) (ids []int, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(THREAD_BUCKET).Cursor()
+		for k, _ := c.Seek(threadKey(after + 1)); k != nil && (limit <= 0 || len(ids) < limit); k, _ = c.Next() {
+			ids = append(ids, keyThreadID(k))
+		}
+		return nil
+	})
+	return
+}
+
+func (s *Storage) ListByForum(forumId, after, limit int) (ids []int, err error) {
+	prefix := threadKey(forumId)
+	err = s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(FORUM_THREADS_BUCKET).Cursor()
+		for k, _ := c.Seek(forumThreadKey(forumId, after+1)); k != nil && bytes.HasPrefix(k, prefix) && (limit <= 0 || len(ids) < limit); k, _ = c.Next() {
+			ids = append(ids, keyThreadID(k[4:]))
+		}
+		return nil
+	})
+	return
+}
+
+func (s *Storage) Scan(fn func(*stage1stpb.Thread) error) error {
+	return s.ScanAfter(0, fn)
+}
+
+func (s *Storage) ScanAfter(after int, fn func(*stage1stpb.Thread) error) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(THREAD_BUCKET).Cursor()
+		for k, _ := c.Seek(threadKey(after + 1)); k != nil; k, _ = c.Next() {
+			thread, err := readThread(tx, keyThreadID(k))
+			if err != nil {
+				return err
+			}
+			if err := fn(thread); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Storage) Posts(threadId int) (posts []*stage1stpb.Post, err error) {
+	err = s.db.View(func(tx *bolt.Tx) (err error) {
+		posts, err = readPosts(tx, threadId)
+		return
+	})
+	return
+}
+
+func (s *Storage) Snapshots(threadId int) (infos []*stage1stpb.ThreadInfo, err error) {
+	err = s.db.View(func(tx *bolt.Tx) (err error) {
+		infos, err = readSnapshots(tx, threadId)
+		return
+	})
+	return
+}
+
+func (s *Storage) LastSnapshot(threadId int) (info *stage1stpb.ThreadInfo, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		prefix := threadKey(threadId)
+		c := tx.Bucket(HISTORY_BUCKET).Cursor()
+		// Seek past the last key with prefix.
+		k, v := c.Seek(historyKey(threadId+1, 0, 0))
+		if k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
+		}
+		if k == nil || !bytes.HasPrefix(k, prefix) {
+			return nil
+		}
+		info = &stage1stpb.ThreadInfo{}
+		return proto.Unmarshal(v, info)
+	})
+	return
+}
+
+// Sync flushes written data to disk.
+func (s *Storage) Sync() error {
+	return s.db.Sync()
+}
+
 func (s *Storage) Close() {
 	s.db.Close()
 }
 
 func Open(filename string) (Storage, error) {
-	db, err := bolt.Open(filename, 0600, nil)
+	return OpenWithOptions(filename, Options{})
+}
+
+// OpenWithOptions opens the database in filename, creating it if needed, and
+// migrates it to SchemaVersion. It fails if another process keeps the database
+// open.
+func OpenWithOptions(filename string, options Options) (Storage, error) {
+	db, err := openBolt(filename, false)
 	if err != nil {
 		return Storage{}, err
 	}
-	// Create default buckets.
-	err = db.Update(func(tx *bolt.Tx) (err error) {
-		_, err = tx.CreateBucketIfNotExists(BUCKET)
-		return
-	})
-	if err != nil {
+	if err := migrate(db, filename, options); err != nil {
 		db.Close()
 		return Storage{}, err
 	}
 	return Storage{db}, nil
 }
+
+// readMeta returns thread threadId without its posts and visits.
+func readMeta(tx *bolt.Tx, threadId int) (*stage1stpb.Thread, error) {
+	thread := &stage1stpb.Thread{}
+	if err := proto.Unmarshal(tx.Bucket(THREAD_BUCKET).Get(threadKey(threadId)), thread); err != nil {
+		return nil, err
+	}
+	return thread, nil
+}
+
+// readThread assembles thread threadId from the thread, post and history
+// buckets. A thread never stored is empty.
+func readThread(tx *bolt.Tx, threadId int) (thread *stage1stpb.Thread, err error) {
+	if thread, err = readMeta(tx, threadId); err != nil {
+		return nil, err
+	}
+	if thread.Posts, err = readPosts(tx, threadId); err != nil {
+		return nil, err
+	}
+	if thread.ThreadInfos, err = readSnapshots(tx, threadId); err != nil {
+		return nil, err
+	}
+	return
+}
+
+func readPosts(tx *bolt.Tx, threadId int) (posts []*stage1stpb.Post, err error) {
+	prefix := threadKey(threadId)
+	c := tx.Bucket(POST_BUCKET).Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		post := &stage1stpb.Post{}
+		if err := proto.Unmarshal(v, post); err != nil {
+			return nil, err
+		}
+		posts = append(posts, post)
+	}
+	return
+}
+
+func readSnapshots(tx *bolt.Tx, threadId int) (infos []*stage1stpb.ThreadInfo, err error) {
+	prefix := threadKey(threadId)
+	c := tx.Bucket(HISTORY_BUCKET).Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		info := &stage1stpb.ThreadInfo{}
+		if err := proto.Unmarshal(v, info); err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return
+}
+
+// writeThread stores thread in the thread, post and history buckets. Only
+// the posts and visits that changed are written. It returns the thread as
+// stored before without its posts and visits, and the posts that changed.
+func writeThread(tx *bolt.Tx, thread *stage1stpb.Thread) (*stage1stpb.Thread, []rowChange, error) {
+	if err := checkFloors(thread); err != nil {
+		return nil, nil, err
+	}
+	threadId := int(thread.ThreadId)
+	old, err := readMeta(tx, threadId)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta := *thread
+	meta.Posts, meta.ThreadInfos = nil, nil
+	value, err := proto.Marshal(&meta)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := tx.Bucket(THREAD_BUCKET).Put(threadKey(threadId), value); err != nil {
+		return nil, nil, err
+	}
+
+	posts := map[string][]byte{}
+	for index, post := range thread.Posts {
+		if posts[string(postKey(threadId, PostFloor(index, post)))], err = proto.Marshal(post); err != nil {
+			return nil, nil, err
+		}
+	}
+	changes, err := replaceRows(tx.Bucket(POST_BUCKET), threadKey(threadId), posts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	infos := map[string][]byte{}
+	seen := map[int64]int{}
+	for _, info := range thread.ThreadInfos {
+		key := historyKey(threadId, info.Timestamp, seen[info.Timestamp])
+		seen[info.Timestamp]++
+		if infos[string(key)], err = proto.Marshal(info); err != nil {
+			return nil, nil, err
+		}
+	}
+	_, err = replaceRows(tx.Bucket(HISTORY_BUCKET), threadKey(threadId), infos)
+	return old, changes, err
+}
+
+// storedRows returns the rows starting with prefix in bucket as new rows.
+func storedRows(bucket *bolt.Bucket, prefix []byte) (rows []rowChange) {
+	c := bucket.Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		rows = append(rows, rowChange{append([]byte{}, k...), nil, append([]byte{}, v...)})
+	}
+	return
+}
+
+// rowChange is a row written by replaceRows, before is nil for a new row and
+// after for a deleted one.
+type rowChange struct {
+	key, before, after []byte
+}
+
+// replaceRows makes the keys starting with prefix in bucket map to rows,
+// leaving the unchanged ones alone. It returns the rows it changed.
+func replaceRows(bucket *bolt.Bucket, prefix []byte, rows map[string][]byte) (changes []rowChange, err error) {
+	c := bucket.Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		row, found := rows[string(k)]
+		if !found {
+			changes = append(changes, rowChange{append([]byte{}, k...), append([]byte{}, v...), nil})
+		} else if !bytes.Equal(row, v) {
+			changes = append(changes, rowChange{append([]byte{}, k...), append([]byte{}, v...), append([]byte{}, row...)})
+		}
+		delete(rows, string(k))
+	}
+	for k, row := range rows {
+		// An empty row isn't nil, which would delete it.
+		changes = append(changes, rowChange{[]byte(k), nil, append([]byte{}, row...)})
+	}
+	for _, change := range changes {
+		if change.after == nil {
+			err = bucket.Delete(change.key)
+		} else {
+			err = bucket.Put(change.key, change.after)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// threadKey is the key of thread threadId, big-endian so that threads are
+// ordered by id. It starts the keys of the posts and visits of the thread.
+func threadKey(threadId int) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, uint32(threadId))
+	return key
+}
+
+// keyThreadID returns the id of the thread whose key starts key.
+func keyThreadID(key []byte) int {
+	return int(binary.BigEndian.Uint32(key))
+}
+
+func postKey(threadId, floor int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint32(key, uint32(threadId))
+	binary.BigEndian.PutUint32(key[4:], uint32(floor))
+	return key
+}
+
+// historyKey is the key of a visit of thread threadId at unix time timestamp,
+// the index-th one in that second.
+func historyKey(threadId int, timestamp int64, index int) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint32(key, uint32(threadId))
+	binary.BigEndian.PutUint64(key[4:], uint64(timestamp))
+	binary.BigEndian.PutUint32(key[12:], uint32(index))
+	return key
+}
+
+// PostFloor returns the floor of the index-th post of a thread.
+func PostFloor(index int, post *stage1stpb.Post) int {
+	if post.Floor == 0 {
+		// Posts archived before floors were recorded.
+		return index + 1
+	}
+	return int(post.Floor)
+}
+
+// checkFloors fails if two posts of thread are at the same floor, where one
+// would overwrite the other.
+func checkFloors(thread *stage1stpb.Thread) error {
+	seen := map[int]bool{}
+	for index, post := range thread.Posts {
+		floor := PostFloor(index, post)
+		if seen[floor] {
+			return fmt.Errorf("thread %d has two posts at floor %d", thread.ThreadId, floor)
+		}
+		seen[floor] = true
+	}
+	return nil
+}
+
+// SortPosts orders posts by floor like the keys of stored posts.
+func SortPosts(posts []*stage1stpb.Post) {
+	floors := map[*stage1stpb.Post]int{}
+	for index, post := range posts {
+		floors[post] = PostFloor(index, post)
+	}
+	sort.SliceStable(posts, func(i, j int) bool {
+		return floors[posts[i]] < floors[posts[j]]
+	})
+}
@@ -1,14 +1,17 @@
 package storage
 
 import (
+	"github.com/boltdb/bolt"
 	"github.com/golang/protobuf/proto"
 	"github.com/smy20011/s1go/stage1stpb"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"sync/atomic"
 	"testing"
+	"time"
 )
 
 var (
@@ -61,3 +64,521 @@ func ExecTest(m *testing.M) (retcode int) {
 func TestMain(m *testing.M) {
 	os.Exit(ExecTest(m))
 }
+
+func TestSearch(t *testing.T) {
+	boltStore, err := Open(filepath.Join(tmpDir, "search.DB"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stores := map[string]Store{
+		"bolt":   &boltStore,
+		"memory": NewMemoryStore(),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			defer store.Close()
+			testSearch(t, store)
+		})
+	}
+}
+
+// testSearch checks that every Store matches queries the same way.
+func testSearch(t *testing.T, store Store) {
+	store.Put(&stage1stpb.Thread{
+		ThreadId: 1,
+		Title:    "元素法出路在哪里？",
+		Posts: []*stage1stpb.Post{
+			{Author: "噗哩噗", Content: "任务虽然好玩但是开出派罗斯还是想试试"},
+			{Author: "kara2000", Content: "求一台新3DSLL，带猎人X的话最好"},
+		},
+	})
+	store.Put(&stage1stpb.Thread{
+		ThreadId: 2,
+		Title:    "S1游戏区二手游戏交易贴",
+		Posts: []*stage1stpb.Post{
+			{Author: "Meltina", Content: "出PS4全境封锁加辐射4美版"},
+			{Author: "kara2000", Content: "收个n3ds，3DSLL也可以"},
+		},
+	})
+
+	results, err := store.Search("3dsll", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %v", results)
+	}
+	if results, _ := store.Search("3dsll", 1); len(results) != 1 {
+		t.Fatalf("Expected 1 result, got %v", results)
+	}
+	// Terms are words, not parts of them.
+	if results, _ := store.Search("dsll", 10); len(results) != 0 {
+		t.Fatalf("Expected no match inside a word, got %v", results)
+	}
+
+	results, _ = store.Search("元素法", 10)
+	if len(results) != 1 || results[0].ThreadID != 1 || results[0].PostIndex != 0 {
+		t.Fatalf("Title search failed: %v", results)
+	}
+
+	results, _ = store.Search("猎人", 10)
+	if len(results) != 1 || results[0].Snippet != "求一台新3DSLL，带猎人X的话最好" {
+		t.Fatalf("CJK search failed: %v", results)
+	}
+
+	results, _ = store.Search("辐射 猎人", 10)
+	if len(results) != 0 {
+		t.Fatalf("Expected no post containing both terms, got %v", results)
+	}
+
+	thread := &stage1stpb.Thread{
+		ThreadId: 3,
+		Title:    "水果",
+		Posts:    []*stage1stpb.Post{{Floor: 31, Content: "apple"}},
+	}
+	store.Put(thread)
+	// A post backfilled before the stored one.
+	thread.Posts = append([]*stage1stpb.Post{{Floor: 1, Content: "banana"}}, thread.Posts...)
+	store.Put(thread)
+
+	results, _ = store.Search("banana", 10)
+	if len(results) != 1 || results[0].Floor != 1 || results[0].PostIndex != 0 {
+		t.Fatalf("Expected banana at floor 1, got %v", results)
+	}
+	results, _ = store.Search("apple", 10)
+	if len(results) != 1 || results[0].Floor != 31 || results[0].PostIndex != 1 || results[0].Snippet != "apple" {
+		t.Fatalf("Expected apple at floor 31, got %v", results)
+	}
+	results, _ = store.Search("水果 banana", 10)
+	if len(results) != 1 || results[0].Floor != 1 {
+		t.Fatalf("Expected title to match with the first post, got %v", results)
+	}
+
+	// Edited posts are indexed again.
+	thread.Posts[1].Content = "cherry"
+	store.Put(thread)
+	if results, _ := store.Search("apple", 10); len(results) != 0 {
+		t.Fatalf("Expected edited content not found, got %v", results)
+	}
+	if results, _ := store.Search("cherry", 10); len(results) != 1 || results[0].Floor != 31 {
+		t.Fatalf("Expected cherry at floor 31, got %v", results)
+	}
+
+	// A single character matches inside words.
+	if results, _ := store.Search("果", 10); len(results) != 1 {
+		t.Fatalf("Expected single character to match, got %v", results)
+	}
+
+	store.Delete(3)
+	if results, _ := store.Search("cherry", 10); len(results) != 0 {
+		t.Fatalf("Expected deleted thread not found, got %v", results)
+	}
+}
+
+func TestStorage_Restricted(t *testing.T) {
+	storage, err := Open(filepath.Join(tmpDir, "restricted.DB"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+	if err := storage.MarkRestricted(12345, "permission denied"); err != nil {
+		t.Fatal(err)
+	}
+	storage.MarkRestricted(23456, "login required")
+	storage.ClearRestricted(23456)
+	restricted, err := storage.Restricted()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restricted) != 1 || restricted[12345] != "permission denied" {
+		t.Fatalf("Wrong restricted threads %v", restricted)
+	}
+}
+
+func TestStorage_NextVisit(t *testing.T) {
+	storage, err := Open(filepath.Join(tmpDir, "schedule.DB"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+	next, err := storage.NextVisit(12345)
+	if err != nil || !next.IsZero() {
+		t.Fatalf("Expected no next visit, got %v, %v", next, err)
+	}
+	expected := time.Unix(1500000000, 0)
+	if err := storage.SetNextVisit(12345, expected); err != nil {
+		t.Fatal(err)
+	}
+	next, err = storage.NextVisit(12345)
+	if err != nil || !next.Equal(expected) {
+		t.Fatalf("Expected next visit %v, got %v, %v", expected, next, err)
+	}
+}
+
+func TestStorage_BackfillCursor(t *testing.T) {
+	storage, err := Open(filepath.Join(tmpDir, "backfill.DB"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+	if page, err := storage.BackfillCursor(ForumCursor(6)); err != nil || page != 0 {
+		t.Fatalf("Expected no cursor, got %d, %v", page, err)
+	}
+	storage.SetBackfillCursor(ForumCursor(6), 12)
+	storage.SetBackfillCursor(ThreadCursor(6), 3)
+	if page, err := storage.BackfillCursor(ForumCursor(6)); err != nil || page != 12 {
+		t.Fatalf("Expected page 12, got %d, %v", page, err)
+	}
+	storage.ClearBackfillCursor(ForumCursor(6))
+	if page, _ := storage.BackfillCursor(ForumCursor(6)); page != 0 {
+		t.Fatalf("Expected cleared cursor, got %d", page)
+	}
+	if page, _ := storage.BackfillCursor(ThreadCursor(6)); page != 3 {
+		t.Fatalf("Expected page 3, got %d", page)
+	}
+}
+
+func TestStorage_migrate(t *testing.T) {
+	file := filepath.Join(tmpDir, "migrate.DB")
+	db, err := bolt.Open(file, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := &stage1stpb.Thread{
+		ThreadId:    12345,
+		Title:       "title",
+		Posts:       []*stage1stpb.Post{{Content: "first"}, {Content: "second"}},
+		ThreadInfos: []*stage1stpb.ThreadInfo{{Replies: 1, Timestamp: 100}, {Replies: 1, Timestamp: 100}},
+	}
+	db.Update(func(tx *bolt.Tx) error {
+		bucket, _ := tx.CreateBucket(BUCKET)
+		value, _ := proto.Marshal(old)
+		return bucket.Put([]byte("12345"), value)
+	})
+	db.Close()
+
+	pending, err := PendingMigrations(file)
+	if err != nil || len(pending) != len(migrations) {
+		t.Fatalf("Expected %d pending migrations, got %v, %v", len(migrations), pending, err)
+	}
+	storage, err := OpenWithOptions(file, Options{Backup: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+	if _, err := os.Stat(file + ".v0.bak"); err != nil {
+		t.Fatalf("No backup: %v", err)
+	}
+	if version, _ := schemaVersion(storage.db); version != SchemaVersion() {
+		t.Fatalf("Expected version %d, got %d", SchemaVersion(), version)
+	}
+	migrated, err := storage.Get(12345)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Legacy posts are given the floors they are keyed by.
+	old.Posts[0].Floor, old.Posts[1].Floor = 1, 2
+	if !proto.Equal(old, migrated) {
+		t.Fatalf("Expected %v, got %v", old, migrated)
+	}
+	storage.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(BUCKET) != nil {
+			t.Fatal("Old bucket is not removed")
+		}
+		return nil
+	})
+	if ids, _ := storage.RecentThreads(0, 0); len(ids) != 1 || ids[0] != 12345 {
+		t.Fatalf("Expected migrated thread indexed, got %v", ids)
+	}
+	if results, _ := storage.Search("second", 10); len(results) != 1 || results[0].Floor != 2 {
+		t.Fatalf("Expected migrated posts searchable, got %v", results)
+	}
+
+	// Posts and visits removed from the thread are removed from storage.
+	migrated.Posts = migrated.Posts[1:]
+	migrated.ThreadInfos = migrated.ThreadInfos[:1]
+	storage.Put(migrated)
+	thread, _ := storage.Get(12345)
+	if !proto.Equal(migrated, thread) || thread.Posts[0].Floor != 2 {
+		t.Fatalf("Expected %v, got %v", migrated, thread)
+	}
+}
+
+func TestStorage_migrateThreadKeys(t *testing.T) {
+	file := filepath.Join(tmpDir, "keys.DB")
+	db, err := bolt.Open(file, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A database at version 2 keyed by decimal ids.
+	createBuckets(db)
+	migrateThreads(db)
+	db.Update(func(tx *bolt.Tx) error {
+		for _, id := range []int{100, 99} {
+			value, _ := proto.Marshal(&stage1stpb.Thread{ThreadId: int32(id)})
+			tx.Bucket(THREAD_BUCKET).Put([]byte(strconv.Itoa(id)), value)
+		}
+		tx.Bucket(RESTRICTED_BUCKET).Put([]byte("99"), []byte("permission denied"))
+		tx.Bucket(SCHEDULE_BUCKET).Put([]byte("100"), []byte("1500000000"))
+		meta, _ := tx.CreateBucket(META_BUCKET)
+		return meta.Put(versionKey, []byte("2"))
+	})
+	db.Close()
+
+	storage, err := Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+	if ids, _ := storage.ListThreadIDs(); len(ids) != 2 || ids[0] != 99 || ids[1] != 100 {
+		t.Fatalf("Expected threads 99 and 100, got %v", ids)
+	}
+	if thread, _ := storage.Get(100); thread.ThreadId != 100 {
+		t.Fatalf("Cannot find thread 100, got %v", thread)
+	}
+	if restricted, _ := storage.Restricted(); restricted[99] != "permission denied" {
+		t.Fatalf("Wrong restricted threads %v", restricted)
+	}
+	if next, _ := storage.NextVisit(100); next.Unix() != 1500000000 {
+		t.Fatalf("Wrong next visit %v", next)
+	}
+}
+
+func TestStorage_locked(t *testing.T) {
+	defer func(timeout time.Duration) { lockTimeout = timeout }(lockTimeout)
+	lockTimeout = 10 * time.Millisecond
+	file := filepath.Join(tmpDir, "locked.DB")
+	storage, err := Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+	if _, err := Open(file); err == nil {
+		t.Fatal("Expected an error opening a database in use")
+	}
+	if _, err := PendingMigrations(file); err == nil {
+		t.Fatal("Expected an error reading a database in use")
+	}
+}
+
+func TestStorage_newerSchema(t *testing.T) {
+	file := filepath.Join(tmpDir, "newer.DB")
+	storage, err := Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storage.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(META_BUCKET).Put(versionKey, []byte("1000"))
+	})
+	storage.Close()
+	if _, err := Open(file); err == nil {
+		t.Fatal("Expected an error opening a database of a newer schema")
+	}
+	if pending, err := PendingMigrations(filepath.Join(tmpDir, "missing.DB")); err != nil || len(pending) != len(migrations) {
+		t.Fatalf("Expected all migrations for a new database, got %v, %v", pending, err)
+	}
+}
+
+func TestStore(t *testing.T) {
+	boltStore, err := Open(filepath.Join(tmpDir, "store.DB"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stores := map[string]Store{
+		"bolt":   &boltStore,
+		"memory": NewMemoryStore(),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			defer store.Close()
+			testStore(t, store)
+		})
+	}
+}
+
+// testStore checks the behavior every Store shares.
+func testStore(t *testing.T, store Store) {
+	if thread, err := store.Get(1); err != nil || thread.ThreadId != 0 {
+		t.Fatalf("Expected empty thread, got %v, %v", thread, err)
+	}
+	threads := []*stage1stpb.Thread{
+		{
+			ThreadId: 2,
+			ForumId:  6,
+			Title:    "S1游戏区二手游戏交易贴",
+			Author:   "Meltina",
+			Posts: []*stage1stpb.Post{
+				{PostId: 20, Floor: 1, Author: "Meltina", PostTime: 1500000000, Content: "出PS4全境封锁加辐射4美版"},
+				{PostId: 22, Floor: 3, Author: "kara2000", PostTime: 1500000200, Content: "收个n3ds，3DSLL也可以", Deleted: true},
+				{PostId: 21, Floor: 2, Author: "噗哩噗", PostTime: 1500000100, Content: "求一台新3DSLL", Revisions: []*stage1stpb.Revision{
+					{Content: "求一台3DS", ReplacedAt: 1500000000},
+				}},
+			},
+			ThreadInfos: []*stage1stpb.ThreadInfo{
+				{Rank: 3, Replies: 1, Timestamp: 1500000000},
+				{Rank: 1, Replies: 2, Timestamp: 1500000000},
+				{Rank: 2, Replies: 2, Timestamp: 1500000600},
+			},
+		},
+		{ThreadId: 1, ForumId: 75, Title: "元素法出路在哪里？"},
+	}
+	for _, thread := range threads {
+		if err := store.Put(thread); err != nil {
+			t.Fatal(err)
+		}
+	}
+	thread, err := store.Get(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thread.Title != "S1游戏区二手游戏交易贴" || len(thread.Posts) != 3 || len(thread.ThreadInfos) != 3 {
+		t.Fatalf("Wrong thread %v", thread)
+	}
+	for i, post := range thread.Posts {
+		if post.Floor != int32(i+1) {
+			t.Fatalf("Expected posts ordered by floor, got %v", thread.Posts)
+		}
+	}
+	if !thread.Posts[2].Deleted || len(thread.Posts[1].Revisions) != 1 {
+		t.Fatalf("Lost deletion or revision %v", thread.Posts)
+	}
+	if infos, _ := store.Snapshots(2); !proto.Equal(infos[1], threads[0].ThreadInfos[1]) {
+		t.Fatalf("Wrong snapshots %v", infos)
+	}
+	last := threads[0].ThreadInfos[len(threads[0].ThreadInfos)-1]
+	if info, err := store.LastSnapshot(2); err != nil || !proto.Equal(info, last) {
+		t.Fatalf("Expected last snapshot %v, got %v, %v", last, info, err)
+	}
+	if info, err := store.LastSnapshot(3); err != nil || info != nil {
+		t.Fatalf("Expected no snapshot, got %v, %v", info, err)
+	}
+
+	thread.Posts = thread.Posts[:2]
+	store.Put(thread)
+	if posts, err := store.Posts(2); err != nil || len(posts) != 2 {
+		t.Fatalf("Expected 2 posts, got %v, %v", posts, err)
+	}
+	if results, err := store.Search("3dsll", 10); err != nil || len(results) != 1 || results[0].PostIndex != 1 {
+		t.Fatalf("Wrong results %v, %v", results, err)
+	}
+
+	// Legacy posts without floors are at their position among floored posts.
+	mixed := &stage1stpb.Thread{ThreadId: 4, Posts: []*stage1stpb.Post{
+		{Content: "legacy"},
+		{Floor: 3, Content: "floored"},
+	}}
+	if err := store.Put(mixed); err != nil {
+		t.Fatal(err)
+	}
+	if posts, err := store.Posts(4); err != nil || len(posts) != 2 || posts[0].Content != "legacy" || posts[1].Content != "floored" {
+		t.Fatalf("Expected both posts, got %v, %v", posts, err)
+	}
+	mixed.Posts = append(mixed.Posts[:1], &stage1stpb.Post{Content: "legacy at floor 2"}, &stage1stpb.Post{Floor: 2, Content: "floored"})
+	if err := store.Put(mixed); err == nil {
+		t.Fatal("Expected posts at the same floor rejected")
+	}
+	if posts, _ := store.Posts(4); len(posts) != 2 || posts[1].Floor != 3 {
+		t.Fatalf("Expected the thread unchanged, got %v", posts)
+	}
+	store.Delete(4)
+
+	if ids, err := store.RecentThreads(0, 0); err != nil || len(ids) != 2 || ids[0] != 2 || ids[1] != 1 {
+		t.Fatalf("Wrong recent threads %v, %v", ids, err)
+	}
+	if ids, _ := store.RecentThreads(6, 1); len(ids) != 1 || ids[0] != 2 {
+		t.Fatalf("Wrong recent threads of forum 6 %v", ids)
+	}
+	if posts, _ := store.PostsByAuthor("kara2000", time.Unix(0, 0), time.Unix(2000000000, 0)); len(posts) != 0 {
+		t.Fatalf("Expected removed post not found, got %v", posts)
+	}
+	posts, err := store.PostsByAuthor("噗哩噗", time.Unix(1500000000, 0), time.Unix(1500000101, 0))
+	if err != nil || len(posts) != 1 || posts[0].ThreadID != 2 || posts[0].Floor != 2 || posts[0].Post.PostId != 21 {
+		t.Fatalf("Wrong posts by author %v, %v", posts, err)
+	}
+	posts, err = store.PostsBetween(time.Unix(1500000000, 0), time.Unix(1500000100, 0))
+	if err != nil || len(posts) != 1 || posts[0].Post.PostId != 20 {
+		t.Fatalf("Wrong posts between %v, %v", posts, err)
+	}
+
+	if ids, err := store.ListThreadIDs(); err != nil || len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+		t.Fatalf("Wrong thread ids %v, %v", ids, err)
+	}
+	if ids, err := store.ListThreadIDsAfter(1, 1); err != nil || len(ids) != 1 || ids[0] != 2 {
+		t.Fatalf("Wrong thread ids after 1 %v, %v", ids, err)
+	}
+	if ids, err := store.ListByForum(6, 0, 0); err != nil || len(ids) != 1 || ids[0] != 2 {
+		t.Fatalf("Wrong threads of forum 6 %v, %v", ids, err)
+	}
+	if ids, err := store.ListByForum(6, 2, 0); err != nil || len(ids) != 0 {
+		t.Fatalf("Wrong threads of forum 6 %v, %v", ids, err)
+	}
+	scanned := []int32{}
+	store.Scan(func(thread *stage1stpb.Thread) error {
+		scanned = append(scanned, thread.ThreadId)
+		return nil
+	})
+	if len(scanned) != 2 || scanned[0] != 1 {
+		t.Fatalf("Wrong threads scanned %v", scanned)
+	}
+	scanned = scanned[:0]
+	store.ScanAfter(1, func(thread *stage1stpb.Thread) error {
+		scanned = append(scanned, thread.ThreadId)
+		return nil
+	})
+	if len(scanned) != 1 || scanned[0] != 2 {
+		t.Fatalf("Wrong threads scanned after 1 %v", scanned)
+	}
+
+	failure := Failure{Kind: "network error", Time: time.Unix(1500000000, 0)}
+	if err := store.RecordFailure(2, failure); err != nil {
+		t.Fatal(err)
+	}
+	store.RecordFailure(3, failure)
+	store.ClearFailure(3)
+	if failures, err := store.Failures(); err != nil || len(failures) != 1 || failures[2] != failure {
+		t.Fatalf("Expected the failure of thread 2, got %v, %v", failures, err)
+	}
+	store.ClearFailure(2)
+
+	store.MarkRestricted(1, "permission denied")
+	store.RecordFailure(1, failure)
+	store.SetNextVisit(1, time.Unix(1500000000, 0))
+	if err := store.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	if thread, _ := store.Get(1); thread.ThreadId != 0 {
+		t.Fatalf("Expected deleted thread, got %v", thread)
+	}
+	if ids, _ := store.RecentThreads(0, 0); len(ids) != 1 || ids[0] != 2 {
+		t.Fatalf("Expected deleted thread not listed, got %v", ids)
+	}
+	if restricted, _ := store.Restricted(); len(restricted) != 0 {
+		t.Fatalf("Expected no restricted thread, got %v", restricted)
+	}
+	if failures, _ := store.Failures(); len(failures) != 0 {
+		t.Fatalf("Expected no failed thread, got %v", failures)
+	}
+	if next, _ := store.NextVisit(1); !next.IsZero() {
+		t.Fatalf("Expected no next visit, got %v", next)
+	}
+
+	store.SetBackfillCursor(ForumCursor(6), 12)
+	if page, err := store.BackfillCursor(ForumCursor(6)); err != nil || page != 12 {
+		t.Fatalf("Expected page 12, got %d, %v", page, err)
+	}
+	store.ClearBackfillCursor(ForumCursor(6))
+	if page, _ := store.BackfillCursor(ForumCursor(6)); page != 0 {
+		t.Fatalf("Expected cleared cursor, got %d", page)
+	}
+
+	// Threads moved to another forum are indexed there.
+	thread, _ = store.Get(2)
+	thread.ForumId = 7
+	store.Put(thread)
+	if ids, _ := store.ListByForum(6, 0, 0); len(ids) != 0 {
+		t.Fatalf("Expected no thread in forum 6, got %v", ids)
+	}
+	if ids, _ := store.RecentThreads(7, 0); len(ids) != 1 || ids[0] != 2 {
+		t.Fatalf("Expected thread 2 in forum 7, got %v", ids)
+	}
+}
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/smy20011/s1go/stage1stpb"
+)
+
+// Store keeps the threads archived by the crawler, with their posts and
+// snapshots of their rank and replies, and the state of the crawl. Storage
+// keeps them in BoltDB, MemoryStore in memory and SQLiteStore in SQLite.
+type Store interface {
+	// Get returns thread threadId, an empty thread if it was never stored.
+	Get(threadId int) (*stage1stpb.Thread, error)
+	Put(thread *stage1stpb.Thread) error
+	// Delete removes thread threadId with its posts and snapshots.
+	Delete(threadId int) error
+	// ListThreadIDs returns the ids of the stored threads in order.
+	ListThreadIDs() ([]int, error)
+	// ListThreadIDsAfter returns the first limit ids greater than after, or
+	// all of them if limit isn't positive. The last id returned is the after
+	// of the next page.
+	ListThreadIDsAfter(after, limit int) ([]int, error)
+	// ListByForum is ListThreadIDsAfter for the threads of forum forumId.
+	ListByForum(forumId, after, limit int) ([]int, error)
+	// Scan calls fn with the stored threads in order of id, until fn returns
+	// an error, which is returned.
+	Scan(fn func(*stage1stpb.Thread) error) error
+	// ScanAfter is Scan starting after thread after, to resume a scan.
+	ScanAfter(after int, fn func(*stage1stpb.Thread) error) error
+	// Posts returns the posts of thread threadId ordered by floor.
+	Posts(threadId int) ([]*stage1stpb.Post, error)
+	// Snapshots returns the rank and replies of thread threadId seen at each
+	// visit, oldest first.
+	Snapshots(threadId int) ([]*stage1stpb.ThreadInfo, error)
+	// LastSnapshot returns the latest of the snapshots of thread threadId, nil
+	// if it was never visited.
+	LastSnapshot(threadId int) (*stage1stpb.ThreadInfo, error)
+	// Search returns at most limit posts containing every term of query,
+	// ordered by relevance.
+	Search(query string, limit int) ([]SearchResult, error)
+	// RecentThreads returns the ids of at most limit threads of forum forumId,
+	// or of all forums if forumId is 0, by time of their latest post, most
+	// recent first. A limit that isn't positive returns all of them.
+	RecentThreads(forumId, limit int) ([]int, error)
+	// PostsByAuthor returns the posts of author posted in [from, to), oldest
+	// first.
+	PostsByAuthor(author string, from, to time.Time) ([]PostRef, error)
+	// PostsBetween returns the posts posted in [from, to), oldest first.
+	PostsBetween(from, to time.Time) ([]PostRef, error)
+
+	MarkRestricted(threadId int, reason string) error
+	// ClearRestricted only writes if thread threadId was marked restricted.
+	ClearRestricted(threadId int) error
+	Restricted() (map[int]string, error)
+	RecordFailure(threadId int, failure Failure) error
+	// ClearFailure only writes if thread threadId has a failure recorded.
+	ClearFailure(threadId int) error
+	Failures() (map[int]Failure, error)
+	SetNextVisit(threadId int, next time.Time) error
+	NextVisit(threadId int) (time.Time, error)
+	BackfillCursor(cursor string) (int, error)
+	SetBackfillCursor(cursor string, page int) error
+	ClearBackfillCursor(cursor string) error
+
+	// Sync flushes written data to disk.
+	Sync() error
+	Close()
+}
@@ -1,10 +1,21 @@
 // Code generated by go-bindata.
 // sources:
+// test_util/data/api-forumdisplay.json
+// test_util/data/api-forumindex.json
+// test_util/data/api-viewthread.json
 // test_util/data/bindata.go
 // test_util/data/forum.html
+// test_util/data/forumdisplay.html
+// test_util/data/forumindex.html
 // test_util/data/index.html
+// test_util/data/login.html
+// test_util/data/loginform-seccode.html
+// test_util/data/loginform.html
+// test_util/data/nopermission.html
 // test_util/data/single.html
+// test_util/data/thread-deleted.html
 // test_util/data/thread.html
+// test_util/data/viewthread.html
 // DO NOT EDIT!
 
 package data
@@ -72,6 +83,66 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
+var _dataApiForumdisplayJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\x51\x6b\xec\x44\x14\xc7\xdf\xfd\x14\x97\xf3\x9c\x85\x99\x24\x93\xcd\xe6\x51\xc1\x37\x41\xbc\x7a\xe1\x22\x97\x65\x36\x99\x6d\xc6\x26\x9b\x30\x33\xf1\xde\xa5\x14\x2e\x88\xb6\xd5\x96\x5a\x6b\xa9\x45\xa5\x22\x0a\xd5\x87\x55\xb0\x68\x59\xf5\xd3\xb4\xd9\x6e\x9f\xfc\x0a\x32\x93\xa4\x24\xb6\x85\xda\xfb\x96\x39\xe7\xcc\x39\xe7\x7f\x7e\x39\xb3\x06\x4f\x98\x90\x3c\x9b\x40\x00\x2e\x58\xf0\x46\x4c\x85\x64\x0a\x02\x78\xef\xdd\x37\x7b\x3e\x58\xf0\x84\x0a\x4e\x47\x09\x93\x10\xac\x41\x98\x65\xab\x9c\xe5\x82\x41\x00\xaf\xf7\x9f\x0e\x86\x36\x76\xec\x21\x58\x40\x0b\x15\x43\x30\x29\x92\xc4\x02\x49\x13\xb5\xca\xa6\x10\xc0\x0b\xf4\x34\xf6\xdf\xa1\x3a\x4d\xca\xd2\x11\x13\xc3\x82\x47\x10\x00\x6a\x19\x24\x13\x13\x9a\xea\x84\x60\xc1\x8a\xc8\x8a\xdc\x84\xf4\xc1\x82\x71\x26\xd2\x98\xca\x18\x02\xf0\xc7\x36\x25\x21\x66\x60\x01\x97\x69\x16\x31\x41\x55\x26\x9a\x8a\x82\xd1\x88\x86\x21\x93\x12\x02\xc0\xd5\xcd\x22\xd5\x0d\x8f\x4d\x32\xad\x6c\x5c\xe4\xb5\x53\x4d\x73\x5d\xae\x8a\xb1\xa0\xae\xbe\x38\x3b\x5b\x6c\xee\x2e\x67\xf3\xf2\xdb\xaf\x75\x50\xac\x93\xea\x7c\x04\x21\xa4\x1b\xce\x33\xa9\xae\xcf\x95\x85\x4a\xf9\x3c\x13\x8d\xa2\x9c\x87\x52\x4d\x13\x9d\x1b\xc1\x7a\x2d\x46\x37\xd1\x55\x65\x4e\x8a\xab\xa4\xae\x5a\xce\xbe\xd7\xd1\xa6\x9d\x61\x55\x36\xe1\x52\x41\xf0\xfe\x1a\x28\xd3\x3e\x76\xb0\x43\x88\x5b\xb7\x6e\x4c\xc4\x87\x4a\x76\xce\x44\xda\xd4\x17\x3c\x64\xf5\xb7\xe6\xa1\xe7\x03\x6f\xb1\x44\xf1\x09\xbd\x36\x99\xdb\x8e\xed\x12\x0f\x2c\x90\xc5\xe8\x03\x16\x6a\xda\x8f\x71\xa5\xbf\xdc\x9e\x5f\xcc\xb7\x17\x5b\x9f\x55\xc7\x8b\xf9\x0f\x8b\xaf\xf6\x97\xa7\xa7\x60\x41\x44\x15\x4b\xf8\x44\x57\xb0\x11\xf6\x7a\xfd\x9e\xad\x47\x9d\x50\xa9\xf4\x68\x20\x80\x72\xe7\xd3\xf2\xd7\xdd\xc5\xe1\xef\xe5\xd6\x4e\xcb\xc3\x74\x1f\x29\x8f\x8b\xa9\xc6\xf7\x21\x67\xcf\xcd\x18\xbd\xbe\x3f\x30\x22\xf2\x84\xeb\xbf\x0b\xb0\xed\x68\x8d\x11\x97\x79\x42\xa7\x99\x88\xcc\x45\xc7\x98\x56\x98\xa9\xa0\xa5\xc9\x9c\x85\x9c\x26\x8d\x50\xa5\x68\x18\xa7\x6c\xa2\xdd\xb6\xc9\x17\x66\x69\xca\x26\xd1\x90\x46\x0d\x19\xc1\xf2\x64\x1a\x0a\x16\xf1\x26\x49\x34\x6a\xc9\xc1\xae\x37\xc0\xc8\xf3\xdd\xca\xd3\x52\x84\x09\x46\x2e\xc2\x9e\xc1\x2d\x0a\x19\x9b\x4c\x35\xe0\x6b\x3c\xee\xc0\x1d\xb8\xa4\x8d\x07\xdd\x93\x4e\x79\x74\x58\xee\xff\x54\x1e\x1d\x76\xf9\x10\x97\x60\xdb\xe9\x00\x2a\x3f\xfe\xe8\xf2\xf4\xbb\xc5\x6f\x07\xe5\xc6\x7c\xf9\xc7\x2f\xe5\x37\x27\xe5\xfe\xcf\x57\x1b\xdb\xff\xfc\x75\x7c\x83\x4d\xbf\xe7\xf6\xfa\x5d\x34\x8d\xf5\x11\x76\x03\xec\xfd\x17\x4e\xbb\x8d\x86\x8f\x43\x3a\x70\xd0\x4d\x32\xe8\x7f\x90\x41\x0f\x25\x33\xc0\xc4\x25\x7d\xef\x26\x99\xb6\xe7\x4e\x32\x66\x57\x9d\x36\x19\x0f\xdf\x13\x0d\x4b\x5e\x4c\xbb\x54\x30\x42\xb8\xc3\xe4\xfc\xe5\xe7\x6f\x3f\x76\xcf\x5f\xee\x5d\x1e\xfd\x7d\xb5\xb1\xb7\xf8\xf2\xcf\xcb\x83\x1f\x97\xb3\x93\xe5\x6c\x7e\x0b\x13\x8c\x7b\x18\x75\xa9\x38\xe4\x51\xb9\xf9\xc9\xd5\x17\xc7\xb7\x2c\xcc\x2a\x15\xd4\xae\x5e\x9e\x06\x89\x8d\x5c\xbf\x03\xc5\xf7\x5f\x8d\xca\x43\xf7\x85\x60\xe4\x20\xe4\xa3\xbb\xf6\xc5\xb9\x6d\x5f\x9e\x99\xd1\xd5\x4f\xdb\x33\x0b\x54\xae\x1f\x64\xa2\x23\x73\xba\xc2\x20\x00\x0c\xeb\xeb\xaf\xfd\x3b\x00\x67\xe8\x3f\x2c\x92\x06\x00\x00")
+
+func dataApiForumdisplayJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataApiForumdisplayJson,
+		"data/api-forumdisplay.json",
+	)
+}
+
+func dataApiForumdisplayJson() (*asset, error) {
+	bytes, err := dataApiForumdisplayJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/api-forumdisplay.json", size: 1682, mode: os.FileMode(420), modTime: time.Unix(1792311062, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataApiForumindexJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xcf\x6e\xd3\x4c\x14\xc5\xf7\xdf\x63\x9c\xf5\x7c\x8d\xed\xd4\x4d\x3a\x4b\x90\x78\x00\x04\x95\xaa\x2a\x8a\x6e\xec\x49\x3c\xaa\xc7\x63\xcd\x8c\x81\xaa\xf2\x86\x0d\x7f\x16\xc0\x8a\x0d\x42\x20\xc4\x02\x09\x51\x16\xb0\x88\xa0\x8f\xd3\xa6\xe5\x2d\xd0\xd8\x4d\x1d\x42\x54\xb1\xf3\x3d\xd6\xbd\xbf\x73\x8e\x7d\x8c\x3d\x61\xac\xd4\x05\x38\xb6\xc1\x70\x3b\x23\x63\x85\x03\xc7\xfd\x7b\x77\xfe\x1f\x82\x61\x8f\x8c\xa4\x49\x2e\x2c\xf8\x31\x12\xad\x0f\xa5\x28\x8d\x00\xc7\xad\xc1\xfe\xee\x38\x0a\xfb\xd1\x18\x0c\x54\xb9\x0c\xbc\xa8\xf2\x9c\xc1\x52\xee\x0e\xc5\x11\x38\x1e\x05\xfb\xd9\xf0\x2e\xf9\x33\x4a\xa8\x89\x30\xe3\x4a\xa6\xe0\x08\x56\x04\x2b\x4c\x41\xca\x1f\xec\x44\x7a\x40\x8e\x0c\x38\x32\xe7\x4a\xcb\x7b\xbd\x56\xd8\xb2\x64\x68\x42\xa1\x75\x5b\x89\x56\x3d\xa9\x68\x26\x6c\xaf\xd0\xed\xdb\xb1\x55\x94\xe7\x5b\x33\x39\x05\xc3\xcc\xe8\xaa\x6c\x58\x03\x30\x4c\xb5\x51\x19\xd9\x0c\x1c\xc3\x69\x44\x71\x12\x0a\x30\x48\xab\x74\x2a\x0c\x39\x6d\x96\xd6\x8d\xa0\x94\x92\x44\x58\x0b\x8e\x10\x0c\x85\x76\x32\x11\x3e\x7a\x21\x1e\x96\x55\x73\xc2\xbb\xf7\x93\x5a\x79\x36\x5a\x95\xae\x9b\xd5\x51\xa9\x6d\x3b\xd7\xd7\xa9\x84\x22\x99\x2f\x49\x57\x5a\x62\x44\x2a\x9d\xbd\xda\xb4\xc2\x39\x59\xcc\xc6\x93\x49\x92\x6b\x2b\xbc\xfd\x65\x16\x6f\xe1\xcf\x50\xcd\xe4\xa4\xcb\x7d\x77\x8b\xf9\xfc\xfc\xe4\x03\x6a\x86\x84\x5c\x2e\x3d\xfb\xe0\x18\xd3\xa6\x81\x26\x47\x5b\xf1\xd9\xfc\xe7\xe5\xc9\x8f\xf3\xb7\x6f\xda\x52\x2a\x65\xc1\x0f\x30\x88\xc1\x9a\xaf\xbf\x83\x51\xcd\x96\x6b\x51\xb7\x76\xfe\xe5\xd5\x86\xb5\xb0\x1f\x61\x54\x8f\xae\xa4\x35\xe8\x20\x5e\x59\xff\xf8\xfa\xd7\x93\x17\x60\x70\x99\x2f\xd8\xa7\x1d\x06\x41\xe0\x13\xfb\x9a\xfc\xbc\xeb\xe7\x46\x71\x3a\xa5\xa3\xa5\x1c\x06\x91\xf7\x95\x0a\x9b\x18\x59\xba\xf6\x3f\x45\xe7\x71\xbb\xf3\xb8\x98\xcf\x17\x4f\x5f\x5e\xdb\xec\x50\xf1\x1a\x2a\xde\x8c\x8a\xc3\xe8\x26\xd2\xce\x4a\x9c\xe7\x9f\x16\xa7\x9f\x37\x90\xfa\x6b\xa4\xfe\x66\x52\x70\x13\xc7\x97\x7a\x4d\xba\x78\xfc\xec\xe2\xdd\xb7\xb3\xd3\xf7\x97\x5f\xbf\xff\x45\x5a\x01\x85\xff\xcc\x19\xd5\xf5\x7f\xbf\x07\x00\x12\x74\xad\xfa\xf4\x03\x00\x00")
+
+func dataApiForumindexJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataApiForumindexJson,
+		"data/api-forumindex.json",
+	)
+}
+
+func dataApiForumindexJson() (*asset, error) {
+	bytes, err := dataApiForumindexJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/api-forumindex.json", size: 1012, mode: os.FileMode(420), modTime: time.Unix(1792311062, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataApiViewthreadJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x6f\x6f\xdb\xd6\xf5\x7e\xff\xfb\x14\xc4\x29\xf0\x7b\x25\x8b\xbc\xa4\x64\xc9\x9a\xa4\x21\x99\x5b\x74\x58\x2d\x60\x4b\x5a\xb4\x09\x0d\xe1\x8a\xbc\x12\xaf\xcd\x7f\xe3\xbd\x8c\xac\x04\x06\xb2\xc1\x6b\x13\xc4\x89\x1b\x6c\x33\x96\x20\x79\x51\x60\x5d\x87\x61\xc3\xd2\xae\x18\x34\xd7\x6b\xbe\x8c\x28\xb9\xaf\xfa\x15\x86\x43\x52\xb2\x24\xdb\x89\x1b\x54\x6f\x74\xff\xf0\xde\x73\xce\x73\xce\x79\x1e\xf2\x0e\x7c\xc0\x22\xc1\x03\x1f\x6a\x50\x82\x02\xfc\xcc\xa1\x91\x60\x12\x6a\xf0\xfe\xf5\x77\x56\xaa\x50\x80\x0f\x68\xc4\x69\xc7\x65\x02\x6a\x77\xc0\x0a\x82\x6d\xce\xc2\x88\x41\x0d\xae\x56\x3e\x5a\x6b\xeb\xc4\xd0\xdb\x50\x00\x1a\x4b\x07\x6a\x7e\xec\xba\x05\x10\xd4\x95\xdb\x6c\x00\x35\xd8\xd1\x3e\x72\xaa\xbf\xa2\x78\x8d\xc7\xbc\x0e\x8b\xda\x31\xb7\xa1\x06\xda\xdc\x82\x60\x91\x4f\x3d\xbc\x10\x0a\xd0\x8b\x82\x38\x4c\x1f\xa9\x40\x01\xba\x41\xe4\x39\x54\x38\x50\x83\x6a\x57\xa7\x65\x8b\x30\x28\x00\x17\x5e\x60\xb3\x88\xca\x20\x9a\x5a\x8c\x18\xb5\xa9\x65\x31\x21\xa0\x06\x04\x0a\x20\x1d\x5c\x42\x8f\x65\x7a\x1b\x31\x88\x51\x2e\x63\x80\xdd\x74\x8e\xa3\x30\x10\x52\x62\x64\x33\x97\xe4\x20\xcc\x26\x65\xf4\x58\x04\x91\x9c\x6d\xe1\x75\x21\x8b\xbc\x7c\x1a\x46\xdc\x62\xf9\x18\x43\x47\x57\x60\x83\xb9\x92\xfb\x74\xb6\x94\x1e\x36\xf4\x52\x79\x15\x6f\x8b\x3b\x5b\xcc\x42\x60\xaf\x91\xf1\x70\x38\xbe\x77\x90\xec\x1f\x8d\x8e\xf6\xc7\xf7\x1f\x64\xd3\xd1\xd1\x9f\xc7\x7f\xfa\xfd\xc9\xd7\x5f\x43\x01\x6c\x2a\x99\xcb\x7d\xb4\x40\x4a\xab\x6b\x44\x5b\xad\x96\xd0\x94\x4b\x85\x44\xb7\x71\xbd\x4c\xb4\x92\x46\x56\xb5\xf9\x75\x86\x6e\x78\xdc\x89\x07\x08\xd4\x2d\xce\xfa\x08\x48\x79\xb5\x52\x5d\x4b\x63\x08\x5d\x8e\x79\x04\xa2\x1b\x88\x80\xcd\x45\xe8\xd2\x41\x10\xd9\xe9\x41\x03\x0a\xe0\xf0\x9e\xe3\xf2\x9e\x23\xf3\xe0\x6c\xde\x63\x62\x3a\x89\xa8\x64\x39\xc0\x22\x64\x16\xa7\x6e\xbe\x41\xa5\xa4\x96\xe3\x31\x1f\x9f\xd4\xa1\x00\x96\x1b\x08\x36\xc5\x8e\xba\xee\x19\xd3\xa9\x4d\xc4\x3b\x7f\x26\x62\x56\xe0\x79\xcc\x9f\x9e\x71\x18\x95\xb3\xc7\x77\x97\xd3\xe6\x72\xf4\xe9\xe6\x1d\x08\x73\x8c\x35\x8d\x68\x1a\xfa\x75\x36\xdd\x11\x3e\x0a\xe4\xd2\x89\x9a\xc3\x5e\xd7\xc8\xea\x4a\x65\x45\x27\x8a\x4e\x6a\x04\xaf\xf3\x98\x10\xb4\x87\x20\xd4\xb9\x62\xb9\x54\x88\x86\x09\xa1\x90\x54\xc6\xc2\x84\xa6\x32\x7e\xf6\xf7\x64\x78\x38\x7e\x76\x37\xf9\xf4\xd1\xe4\x0f\x5f\x2a\xb9\x25\x65\x74\xf4\x48\x99\xde\xa6\x2b\x84\xd4\xca\x65\x65\x72\x7c\x78\xf2\xed\x63\xa5\xae\xf2\x66\xbd\x13\x29\x6a\xd3\x8c\x4c\xff\x74\x34\xfa\xcf\x83\xe4\xfe\xc3\xc9\xd3\xbd\x64\x78\x98\xfc\xe3\xd3\xe4\xe0\xde\xf8\xab\xcf\x46\x47\x1f\x7f\x7f\xbc\x9f\x7c\xfc\x30\x39\xbe\x3b\x1a\xde\x1d\x0d\xff\x36\x3e\x7c\x31\x79\xba\x77\x7a\x2c\x39\x78\x9c\x0c\x0f\x4f\xbe\xd8\x4b\xee\x3d\xf9\xfe\xf8\x69\x9d\x2a\x4e\xc4\xba\x0d\x13\x1c\x29\x43\x51\x53\xd5\x4e\x47\x14\x05\x8d\x68\x87\x12\x21\x8b\x56\xe0\xa9\x7a\x47\xcd\xfa\x65\x85\x18\x1a\xfe\x56\xc8\x0a\x29\x3a\xd2\x73\x4d\x50\x24\x8d\x7a\x4c\x36\x4c\x68\x77\x5c\xea\x6f\x9b\xd0\x9c\x3c\x7b\x70\xf2\xf2\xc9\x77\x9f\xec\xd7\x55\x7a\xae\xe7\x37\xb3\x72\xd8\x24\x9a\x5e\xba\xa9\xe6\x13\xc4\xdf\x0f\xfc\x81\x17\xc4\xe2\xa2\xaa\xc9\x70\xcc\x77\xb1\x64\x06\x56\xc4\x6c\x3e\x2d\xbf\x30\x10\x5c\x66\x54\x85\xe9\x9c\x63\x8e\xb9\x84\xda\x1e\xf7\x67\x5d\x7b\x4a\x27\x7a\x79\x46\x39\x0b\x56\xfc\x18\x69\x29\xbf\xd1\xee\x5c\xd4\x78\xa7\xae\xa6\x34\x88\x91\xe1\x3f\x4d\x2d\xa5\xb3\xb3\xa5\x77\xa6\x36\xe3\xcb\xd7\x59\x97\xbb\x2c\x8f\x2d\x8a\x5d\x26\x8a\x5b\x61\x2f\x5f\x16\xfc\x36\x86\x5c\xd5\x4b\x86\x7e\x06\x44\x24\x84\x8a\xaa\x13\x55\x27\xa4\xa4\x69\x94\xd2\xfc\x64\xc4\xbc\x60\xd6\xbc\x36\x13\x56\xc4\xc3\x1c\xcb\x57\xb3\x1b\x17\xdc\xcb\x2a\x1e\x31\xef\x73\x1b\x79\x1e\x32\xda\x91\x4e\xec\x75\xa6\x67\xb8\x35\x83\x9d\xed\x60\xca\x32\xc3\xdc\xeb\x51\x57\x2e\xc5\x91\x39\xcd\xad\xd4\x7e\x9d\x7b\x3d\x45\x44\x56\xc3\x04\x4c\x0d\xb7\xd4\xd4\xa2\x8a\x18\x20\x43\x64\xd3\xb6\x28\xf6\x78\xd7\x04\xa5\x93\x52\x47\xc3\x04\xcd\x84\x59\x0b\xde\xf2\x4c\x50\xa8\x8b\x75\x6a\x82\xa2\x36\x67\x36\xa6\x68\x69\xc5\xb2\xf2\x8b\xab\xa7\xa6\xbd\x5e\x1e\x51\x48\x07\x33\xa2\x8a\x23\x24\xb4\x69\xa3\x70\xaf\xb7\xd4\x28\xdd\x20\x8a\x3d\xf5\xd5\x85\xc2\x6d\xe6\x5b\x81\x8d\x1b\x1b\xd7\xaf\x0c\x5a\xef\xf6\xb5\x1b\x5b\x6f\x97\x6e\xac\xff\xd2\xb8\xf1\x6e\x7f\xa7\x75\xfd\xed\x7e\x6b\xfd\xca\x4e\x6b\xeb\x4a\xbf\xbb\x7e\xb5\xba\x71\x7d\x63\x67\xe3\xf6\xfb\xa4\x75\xa5\xd1\xc0\x8b\x83\xbe\xef\x06\xd4\xc6\x16\x31\x60\x77\x17\xf1\xa3\x3d\x96\xf3\x5d\x56\x69\x9b\xd3\xba\xb6\x82\xac\xd2\x75\xd8\x2d\x2c\x51\xa1\xfe\x0a\x2a\x5c\xd0\xac\x6d\x1a\x51\x5d\xd3\x4e\xd7\xb2\x1b\x35\x4d\x3f\xbf\x44\x4b\x0a\x59\xad\x69\x6b\x8b\x54\x68\xf3\x5b\xb3\x4c\xfc\x3a\x0e\x24\x33\xa1\x59\xef\xb8\x81\xb5\x9d\xce\x9a\xf5\x6e\xe0\x4b\x05\x53\xd1\x30\x41\xc7\xcd\xcb\xb1\x52\x8a\x77\x31\x74\xc2\x9f\x7a\x81\xdd\x40\x2a\x88\x98\x25\xff\x9f\x7a\xe1\x4f\x7a\x81\x0c\x1a\x5d\xee\xdb\x28\x07\xe9\x4a\xc8\xed\xc6\xb4\xd9\xb2\x05\xc9\xed\x46\x1e\xfe\xb9\x44\x96\xb9\x65\x05\x6e\x10\x35\x4c\x78\x6b\x2d\xfd\x99\xd0\x9c\x92\x76\x72\xf0\xf8\xe4\xb3\xbf\xce\x53\x77\xde\xa0\x75\x15\x4f\x36\x53\xfe\xcb\x87\x6f\x48\xdb\xea\x3c\x4a\xaa\xcd\x6f\xcd\xdd\x34\xfe\xf2\xb7\xa3\xe1\xdd\xe4\xe0\xc5\xf8\xf0\x85\xb1\x7e\xed\xbd\xf7\x90\xf9\x87\x7f\x99\xec\x3f\x1a\x1d\x1d\x7d\x38\x79\xba\x77\xf2\xcf\xe7\xa8\x34\x9f\xff\xf7\xa2\x0e\x12\x1e\x77\xd9\x40\xed\x52\x8b\xe9\x1a\xa9\xa8\x5a\x85\x14\x43\xbf\x67\x82\x82\x3b\x9c\x71\xbb\x61\x02\x31\xb4\xca\x72\x5b\x2d\x75\xd2\xab\x78\x5b\xfb\x41\xbc\xad\x2f\xf2\xf6\x7c\xf5\xbd\x29\x71\xeb\xe7\x11\xb7\x51\xaa\x54\x2a\x06\x5c\xa2\x53\x8c\xcb\x76\x0a\x73\x77\x06\x8b\x5d\x92\xd3\xfa\x45\x5d\xa2\x97\x17\xba\x64\xf2\xed\xa3\xc9\xfd\x7b\x8a\xb1\x7e\x4d\xc9\x5e\xf8\xc6\xc3\xdf\x25\x0f\xff\x78\xf2\xc5\x6f\xbe\x7b\xb2\x37\xfa\xe6\xdf\xaf\x03\x9a\xfc\x20\xa0\x8d\x45\xa0\xa7\xce\xbf\x29\xc8\xc6\xf9\x20\x57\x2b\xda\xf9\xea\x58\x5e\x50\xc7\xf2\xeb\xd4\xd1\x98\xa9\xe3\x65\x30\x9d\x13\x47\xc3\x16\x6d\x24\xc7\xe2\x6d\x1e\x2e\xea\x23\xd1\x4a\xd5\xf2\x45\xfa\x58\x52\xc9\xaa\x5e\xd6\xb4\x4e\xa7\x93\x9f\xfc\x11\xf4\x51\x9b\xd3\xc7\xd7\xab\x63\x66\x76\xa6\x8e\x4b\x81\x2c\x88\x17\xd1\x8a\x7a\xe9\xac\x7a\x69\x3f\x9a\x7a\x9d\x26\x72\x59\xbd\x3e\xec\x93\x1b\x5b\x2d\xa7\xb5\xf5\x8e\xd3\xf2\x33\xf5\xda\xb8\xdd\x33\x5a\xeb\x3f\x2f\xbd\x46\xbd\x2a\xa9\x7a\x9d\x69\xbf\xcd\xf4\x73\x20\xe8\x23\x6d\x67\x6f\xfc\x72\xfa\x11\x97\x4f\x05\xd4\x6e\x6e\xce\x66\x56\x10\x63\xe6\x70\x25\x0c\x43\x04\x0a\x43\x15\x4c\x4a\xee\xf7\xda\x11\xeb\x47\x5c\x32\x7c\xb5\x98\xde\xb2\xb4\x35\x2b\x67\xac\x0e\x94\x93\x76\xf6\xaa\x1b\x52\xfc\x3e\xc5\x2f\x15\x6a\x39\xac\x6d\xc5\x42\x06\x1e\xf7\xbb\x41\x1b\x3d\xf3\x03\x54\xdb\xba\x88\xc3\xe6\x5b\x75\x15\xff\xa0\x00\xe3\xcf\x8f\x47\xc3\x6f\x70\xf0\xd5\x93\xe4\xe0\x31\x0e\x9e\xbf\x4c\x3e\xf9\x17\x14\x20\x79\xf6\x62\xfc\xfc\x25\x6c\xee\xee\xfe\xdf\xff\x06\x00\x8a\xd0\xdb\x66\x51\x0f\x00\x00")
+
+func dataApiViewthreadJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataApiViewthreadJson,
+		"data/api-viewthread.json",
+	)
+}
+
+func dataApiViewthreadJson() (*asset, error) {
+	bytes, err := dataApiViewthreadJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/api-viewthread.json", size: 3921, mode: os.FileMode(420), modTime: time.Unix(1792311062, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _dataBindataGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func dataBindataGoBytes() ([]byte, error) {
@@ -112,6 +183,46 @@ func dataForumHtml() (*asset, error) {
 	return a, nil
 }
 
+var _dataForumdisplayHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\xeb\x4f\xdc\xc6\x16\xff\xce\x5f\x31\x99\xab\x1b\xe0\xc3\xae\xed\x7d\xf0\x5c\xfb\x4a\x17\x72\x75\x2b\x25\x2d\x2a\x54\x4d\x55\x55\xab\x59\x7b\x76\x3d\xc5\x63\xbb\x9e\xd9\x57\x3f\x21\xb5\x29\x21\x0f\x91\x94\x26\x84\x26\x51\xfa\x8a\x44\x53\x89\xa6\x2a\x4a\x11\x49\xfa\xcf\x04\xef\xc2\xa7\xfe\x0b\xd5\x8c\xed\xc5\xbc\x02\x09\xf4\x0b\xf8\x8c\xcf\x39\x73\xce\x6f\xce\xef\xe7\xd9\xd2\xb9\xc9\xf7\x26\x66\x3e\x9a\xba\x00\x6c\x4e\x1d\x30\xf5\xc1\x7f\x2f\xbe\x33\x01\x60\x46\x51\x3e\xcc\x4f\x28\xca\xe4\xcc\x24\xb8\xfc\xff\x99\x4b\x17\x81\x96\x55\xc1\x4c\x80\x5c\x46\x38\xf1\x5c\xe4\x28\xca\x85\x77\x21\x80\x36\xe7\xfe\x98\xa2\x34\x9b\xcd\x6c\x33\x9f\xf5\x82\x9a\x32\xf3\xbe\xd2\x12\xb9\x34\x11\x1c\x3f\x66\x78\x2a\x32\x6b\x71\x0b\x1a\x7d\x25\xf1\x06\xb4\xa8\xe3\x32\xfd\x90\x34\xda\xe8\xe8\x68\x14\x2d\x7d\x31\xb2\x8c\xbe\x12\xc5\x1c\x01\xe1\x9b\xc1\x9f\xd5\x49\x43\x87\x13\x9e\xcb\xb1\xcb\x33\x33\x6d\x1f\x43\x60\x46\x96\x0e\x39\x6e\x71\x45\xc4\x8e\x03\xd3\x46\x01\xc3\x5c\xaf\xf3\x6a\x66\x04\x02\xc5\xe8\x2b\x71\xc2\x1d\x6c\x74\x36\x36\x3a\x57\x17\xb7\xd7\x36\xc3\x87\xf7\x41\x06\x80\x69\x8e\x6a\x58\x63\x5c\x3c\x33\xf9\xac\x30\x0d\x44\x5e\xe1\xb5\xd5\xce\x8b\x5f\x22\xdf\x92\x12\xc5\xc7\xd5\xb8\x88\x62\x1d\xd6\xb0\x8b\x03\xc4\xbd\x20\x55\xc4\x24\x61\x66\xfd\xf3\x73\xe0\x72\x3e\x5b\x88\x36\xae\x20\x86\x81\x1d\xe0\x6a\xd4\x30\x1b\x53\x94\x4a\x85\x65\x19\x0a\x50\x05\x69\x8c\x67\x4d\x8f\x2a\xb9\x8a\x12\x79\x2b\x71\xd3\x15\xcf\x6a\x03\x62\xe9\xd0\x6d\x94\xab\x5e\x50\xa7\x10\x98\x0e\x62\x4c\x87\x7e\x2d\x5a\xb0\x08\xf3\x1d\xd4\x86\xc0\x73\x67\x71\xdb\xf2\x9a\xae\x0e\x49\x75\x00\x37\xb0\xcb\xb3\xb3\xb8\x3d\xe1\x59\x58\xd7\x73\xc3\x83\x20\xc0\xbc\x1e\xb8\xa0\x8a\x1c\x86\xc7\x05\xb0\x16\x69\xc8\xdc\x4d\xbf\x97\xb5\xe9\xa7\x5f\x98\x3c\xf5\x02\x98\x4e\xf2\x2e\x5e\xa3\x6e\xda\x99\xdb\x01\x46\x96\x43\xd8\x6e\x10\x77\x40\x85\x82\x0a\x6d\xee\x0b\xac\xd0\xb2\x29\x96\xaa\x5e\x40\x01\xc5\xdc\xf6\x2c\x1d\xfa\x9e\x88\x44\x75\xee\x99\x1e\xf5\x1d\xcc\xb1\x0e\xbd\x6a\x15\xc6\x28\x53\xcf\x12\x28\x63\x28\x4b\xde\xb5\x90\x29\x66\x52\x87\x12\x8c\xac\x6f\xfb\xff\xa1\x9e\xa5\x73\xcf\x27\x26\xb2\x28\x71\xcf\x23\xea\x8f\xc7\x4e\x49\x94\x5c\xab\x12\x4b\x2f\xc8\x27\xe2\x56\x1d\x0f\x71\xbd\x8d\x99\xb4\x5d\x4f\xd4\x22\x4c\x51\x24\x47\x15\x07\x03\x56\xa7\x14\x05\xed\x78\x9f\x72\x01\x02\x13\x3b\x0e\xf3\x91\x49\xdc\x9a\x0e\xd5\xc8\xf6\x91\x65\x25\xf6\x5e\x4c\x64\x16\x22\x67\x9f\xf7\x0e\x95\x71\x62\xce\x46\x3e\x65\x2d\xaf\xe5\x8b\xc5\x82\x74\x08\xc4\x1f\x2b\x41\x8b\x98\x12\x67\x14\x4f\x4f\xe4\x9f\x89\xfd\x33\x5a\x46\xcb\x8a\x69\x87\x40\x8e\xa6\x0e\xc3\x2b\xab\xe1\x6f\x73\xdd\x97\x6b\x3b\xdf\x3f\xdb\xda\x78\xbe\xf3\xc3\x3d\x90\x01\x9d\xbb\x4f\xbb\x4f\x96\xc3\xc5\x1f\x3b\x0b\x4b\xe1\x8b\x39\x08\x38\x0a\x6a\x98\xeb\xb0\x5c\x71\x90\x3b\x2b\xf2\x13\x5a\x03\x2c\x30\x75\xc8\x38\xe2\xc4\x54\x08\x45\x35\xac\x98\x1e\xa5\x9e\xab\xf8\xc4\x2d\xe7\xb3\x35\x52\x85\x00\x39\x7c\xef\x26\xf1\xc4\x22\xa3\xaf\xa4\x70\x31\xb4\xdc\x4e\x4a\x8f\xa2\xd3\xd5\x7f\x8a\x1a\x88\x99\x01\xf1\xf9\x58\xc3\x23\xd6\x80\x3a\x38\x2e\x46\xd7\x74\x88\x39\xab\x43\x9b\x58\x78\x5a\x80\x32\x23\x9b\x1c\xe8\x8f\xbb\xec\x1f\xec\xcd\x14\xb3\xbd\xa6\x70\x03\xed\x5e\xc7\x3b\xdf\xde\xda\x5e\x5e\x8c\x8a\x09\x37\xee\x42\x63\xdf\x42\x49\x41\xa9\x0a\xf6\x0e\x4a\x83\xe0\x66\x84\xa8\x3c\x7a\x4e\x2c\x3d\xde\x53\xda\xb8\xc5\x03\xa4\xfb\xa8\x86\xff\x9d\x9f\xd4\x52\x95\xa2\x08\xc0\x01\x6e\x13\x96\x2a\x0e\xb4\x18\x87\xc6\xb4\x16\x4b\xc7\x8d\xcd\xad\xcd\x1b\x9d\x85\xeb\x91\xb9\xb5\xf9\x53\xe7\xde\xd2\xf6\xfa\x7a\x54\x10\xf3\x91\x9b\x04\x72\x9f\x41\xe3\xbc\x5b\x61\xfe\x78\x36\x9b\x3d\x8b\x5a\x65\xf9\xc2\xd0\x73\xd0\xc8\x89\x1d\xcf\x36\x6b\x1e\x1a\x79\x99\x55\x11\x7d\xc8\xb3\xb7\xf7\x8c\x6d\xa5\x2d\xce\xdd\x24\x1c\xa7\xc0\xb7\x3d\x8a\x7b\x3b\x0b\xf6\x60\x99\xb1\x4e\x2c\x3d\x9f\x2b\x14\x87\x20\x30\x75\xa8\x41\xe3\x12\x76\x38\x71\x51\xb4\x41\x9c\x03\x53\xa3\x24\xf7\xca\xa9\xda\x50\x66\x38\x93\xd3\xe2\xbd\x4b\x0a\xa6\xbb\xc3\xd7\x2b\xc0\xad\x53\x68\x1c\x4f\x9b\xd8\xbb\x45\x72\xd0\xd0\x72\xf9\x82\xdc\x13\x53\xa3\x38\x34\x3c\x32\x2a\x53\x1f\xc8\x9c\x6a\xed\x75\x9d\xd5\x19\x0e\xa4\x78\x51\x62\xd7\xdb\x38\xe9\x2d\xb2\x0e\xb4\x76\xf8\xe9\x04\xd8\x22\x01\x36\xf9\xa1\x67\x53\xf3\xb8\xa7\x3b\x88\x71\xa1\x57\xff\x4a\x1e\x60\x04\x53\xc2\x8e\x9c\xaa\x0d\x67\x34\x2d\xa3\x69\x20\xa7\x8e\xa9\x2a\x34\xc2\x9b\xd7\xc2\xa7\x8b\x9d\xe5\x67\xe1\xc2\xcd\x1e\x84\x68\x2f\x8c\x8a\x14\x21\x45\x6a\xd5\x5e\xcd\xc2\x3e\x92\x5f\x3b\x87\xb8\x58\xc0\xc0\x83\x04\x16\xce\xa4\x6d\xc5\x83\x9c\xc0\x66\x1b\x87\xb1\x3f\x4d\x7b\xd3\xc6\xe6\xec\xff\xc4\x54\xba\xb8\x59\xae\x70\x77\xa0\xbf\xd0\x3f\xd8\xe3\x77\xe7\xd1\xe3\xee\x83\xeb\x9d\xfb\xeb\x9d\xbb\x4f\x7b\xe7\x25\x61\x0a\x70\x35\xc0\xcc\x86\x46\x77\xe1\x6a\xf8\x70\x39\x92\xbb\x08\x59\x6e\x1b\xfb\x4a\x79\xbd\xf9\x9a\x96\x5d\x2f\xa0\xc8\x49\x74\xba\x30\x5a\x18\x2d\x14\x4f\xae\xd3\x91\xff\x41\x9d\x3e\xad\x24\x57\x3d\xc7\xc2\x41\xd9\xc5\xcd\x48\x97\x8f\x14\x61\x17\x37\xd3\x75\x1d\xcf\xfe\xa8\xe2\x53\xe8\x5f\x78\xe5\x8b\xee\xfa\x77\x9d\xdf\xef\x84\xf3\x9b\xdb\x7f\xfc\x1a\x3e\x58\x0d\x97\x9e\xec\xcc\xdf\xf8\xeb\xc5\xa3\xa4\xc4\xd3\x69\x45\xb1\x50\xd4\x72\xf9\x84\x50\xe1\xca\x72\xb8\xf4\x73\xb8\xb2\x7c\xa8\x5c\x24\x7b\xb4\x88\x06\x0d\x49\x86\x42\x66\xf8\x6d\x94\xe3\xc0\x41\xf6\x12\xe7\xa0\xa1\x26\xb2\x91\x2f\x9e\x8d\x66\xf4\x9a\x3a\xbe\xcb\xc3\x4f\xf6\xa0\x72\xa4\xce\xf5\x28\xe5\x48\xf0\x01\x5a\x61\x4c\x1b\x3a\xb9\x2a\xec\xa5\x48\x51\x55\x55\x35\x7f\x72\x8a\x44\xfe\xff\x18\x45\xa2\x5b\xc8\x31\x2c\xd9\xbd\xaa\x60\x6a\x7c\x7c\x04\xa6\xe9\x1b\xf7\xbe\x4b\x64\x95\x38\x1c\x07\x3a\x6f\xfb\x98\xc4\x5c\x92\x8f\xfa\x90\x06\x8d\xa9\x69\xf9\x59\xf9\x44\x82\x09\xde\x80\x8a\x11\x32\xa7\xa0\xe2\xab\xb9\x5b\x53\xd3\x85\x57\x73\xb7\xbb\x2b\x2f\x77\xe6\x6f\x77\xbe\x79\xde\xbd\xf3\x78\x7b\x6d\x75\x7b\x6d\xb3\xa4\xa0\x23\x01\xac\x12\x07\x8b\x56\x22\x3c\xcb\x2c\x75\xf5\x43\x9c\x23\xd3\x2e\x13\x5a\xeb\x9d\x53\x78\xff\xcf\xee\xc2\xfc\xce\xca\x97\x5b\xcf\x9f\x09\x2f\x52\x73\x75\x88\x2a\x8c\x12\xcb\x72\x70\x0c\xfa\x29\x29\xaf\xa9\xaa\x96\x50\x01\x3b\xad\xf6\xa1\x5c\x37\x7a\x1f\x3b\xf5\x6d\x18\x7e\x60\x0e\x63\x6f\x79\x37\x18\x19\x49\x28\x9e\x53\x0b\x23\x67\x43\xf2\x59\x14\xa0\x9c\xaa\xaa\x49\x63\x89\xfd\xd6\x14\x4f\xcd\xcb\x9b\x5e\x0e\xb4\xd1\xb1\x62\x11\x1a\xf9\x22\x08\xaf\x7e\xb5\xf3\xf5\xa3\x93\x5f\x0e\x14\xf9\xe3\x46\xac\x88\x1f\x74\xe2\xbf\x45\x1a\xc7\xfd\x4b\x62\x6d\x4e\x1d\xa3\xef\xef\x01\x00\xa1\x80\x6d\x30\x8b\x10\x00\x00")
+
+func dataForumdisplayHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataForumdisplayHtml,
+		"data/forumdisplay.html",
+	)
+}
+
+func dataForumdisplayHtml() (*asset, error) {
+	bytes, err := dataForumdisplayHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/forumdisplay.html", size: 4235, mode: os.FileMode(420), modTime: time.Unix(1792310923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataForumindexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x5b\x6f\xdc\x44\x14\x7e\xdf\x5f\x71\x3a\x08\xd4\x4a\xd8\xe3\xf1\xee\x36\xc9\xd6\x36\x12\x49\x25\x90\x0a\x54\xb0\x88\xf2\xb4\x1a\x7b\x66\xed\x51\x3d\x63\x63\x9f\x64\x77\x79\x42\xbc\x00\x7d\x68\xcb\x03\x05\x09\x10\x17\x81\x54\x09\x71\x91\xe0\x21\xa2\xfd\x39\xdd\x6d\xfa\x2f\xd0\x78\x2f\xdd\x84\x26\x84\xaa\x81\x97\x64\xce\xfd\x7c\xe7\x3b\xe3\xd9\xe0\xdc\xce\x1b\xdb\xfd\x77\xaf\x5e\x86\x0c\x75\x0e\x57\xdf\x7e\xf9\xca\xab\xdb\x40\x1c\x4a\xdf\x69\x6f\x53\xba\xd3\xdf\x81\x6b\xaf\xf4\x5f\xbb\x02\xcc\xf5\xa0\x5f\x71\x53\x2b\x54\x85\xe1\x39\xa5\x97\x5f\x27\x40\x32\xc4\xb2\x47\xe9\x68\x34\x72\x47\x6d\xb7\xa8\x52\xda\x7f\x93\x8e\x6d\x2e\x66\x83\x17\x47\x07\xd7\x22\x5d\x81\x82\x44\xad\xc0\x5a\x60\xac\x73\x53\x87\x4f\x48\xc3\xb6\xb6\xb6\xe6\xd1\x8d\xaf\xe4\x22\x6a\x05\x5a\x22\x07\xeb\xeb\xc8\xf7\x76\xd5\x5e\x48\xb6\x0b\x83\xd2\xa0\xd3\x9f\x94\x92\x40\x32\x97\x42\x82\x72\x8c\xd4\xc6\x5e\x82\x24\xe3\x55\x2d\x31\xdc\xc5\xa1\xb3\x49\x80\x46\xad\x00\x15\xe6\x32\x7a\x0b\x79\x2a\x59\x8d\xe0\x00\xd4\xcd\x99\xd6\x0c\x66\xfb\xfb\xb3\x8f\x6f\x4d\x6f\xdc\x9d\xdd\xff\xe9\xe0\x97\x3f\xa7\x5f\x7f\x19\xd0\xb9\xff\xa2\xba\xe1\x5a\x86\x24\x95\x46\x56\x1c\x8b\x6a\xad\xe8\x8e\xaa\x93\xdd\xf7\xcf\xc1\xb5\xb6\xdb\x99\x17\x8a\x79\x2d\x21\xab\xe4\x70\x0e\xb0\xee\x51\x1a\xc7\xb5\x5b\xf3\x8a\xc7\x9c\xd5\xe8\x26\x85\xa6\x7e\x4c\xe7\xde\x74\x01\x32\x2e\xc4\x04\x94\x08\x89\xd9\x1b\x0c\x8b\x6a\x57\x13\x48\x72\x5e\xd7\x21\x29\xd3\x81\x32\x42\x8e\x09\x14\xe6\xba\x9c\x88\x62\x64\x42\xa2\x86\xe7\xe5\x9e\x34\xe8\x5e\x97\x93\xed\x42\xc8\x30\xf4\x37\x2e\x40\x25\x71\xb7\x32\x30\xe4\x79\x2d\x2f\xd9\x09\x0a\xb5\xd7\x24\x1d\x95\xab\x74\xa3\x72\xdd\x90\xe0\x9a\x01\x92\x7c\x69\x5b\xe8\xb4\x39\xa2\x18\xe6\x10\xeb\x23\xba\x58\x43\xac\x47\x00\xc3\x3c\xfd\x7b\x86\x58\x0f\xb2\x85\x36\xf3\xa3\x80\x2f\x26\xd3\x40\x74\xcb\xac\x7c\x29\x55\x22\x64\x04\x6a\x9c\xe4\x32\x24\x24\x7a\xb0\x7f\x6f\x49\x01\x8f\x02\x9a\xf9\x51\x2b\xa0\x42\xed\x2d\xd2\x36\x4d\x73\x94\x69\x51\x4d\x06\x6c\xd5\x7c\xac\x07\xc9\x5a\x92\x56\x80\x3c\xce\x25\x24\x32\xcf\xeb\x92\x27\xca\xa4\x21\xf1\x48\x23\x97\x5c\x88\x95\xbc\x44\x35\xc0\xd8\xa2\xc2\x2a\x0a\x50\xac\xa9\x53\x02\x23\x25\x30\x0b\x89\xdf\x71\xb7\x9e\x3f\x02\x6e\x98\x0f\x54\x62\x06\xe9\xaa\x72\xe3\xdb\x83\xce\x66\x39\x6e\xe6\x7f\x08\xae\xb3\xd1\x75\x98\x6b\x17\x94\x44\x81\xd2\x29\xd4\x55\x12\x12\xc1\x91\x53\x8e\xc8\x93\x4c\x4b\x83\x34\x29\xb4\x2e\x0c\x15\xde\xe2\x34\xd8\xe8\x0e\x54\x52\x18\xb7\x34\x29\x01\x9e\xab\xd4\x84\x24\x97\x43\xb4\x02\x86\xc4\xae\xd1\x7c\x54\x8b\x21\xe5\xcb\x66\x34\xaf\x52\x65\x1c\xeb\xbb\xd6\x92\xc0\xe8\xf8\xb6\xa6\x3f\xdc\x79\xf4\xd1\xcd\x26\x9d\xd4\x4b\x94\xe3\x91\x07\x63\xc5\x08\x34\x57\x22\x24\x0f\xee\xdd\x98\x7d\xfe\x23\x89\xe0\x3c\xf3\xfc\xce\x85\x80\x4a\x1d\x05\x54\xa0\x4d\x2e\xa2\x40\x6a\xcb\xe1\xa3\xef\xbf\xe8\xc1\xa6\xe7\x79\x5e\x63\x7f\x11\xac\x7e\xba\x7f\x67\xf6\xd9\x6f\x3d\xd8\xf2\xbc\x95\x25\xa0\x42\x44\x36\xf0\xc8\xb4\x9a\xe5\xd0\x85\x08\x2b\x29\x54\x25\x13\x7c\x81\xeb\xf2\x12\xda\x6d\xe9\xda\x60\xd6\xc8\x69\x81\x45\x98\xf3\x1a\xcb\xa2\xc6\xe7\x96\x07\x12\xcd\xbe\xfa\x60\x7a\xfb\xe6\xf4\xd6\xa7\x07\xdf\xdd\xed\x81\xef\xb1\x0d\x87\x31\x87\x31\xf0\xbd\x9e\x2d\xcc\x9b\xad\xb2\x45\xa9\xc8\xed\x5f\xb4\xe7\xb3\xa3\xbe\x73\x7a\xe6\xf9\xe6\x92\xf9\xce\x19\x13\xff\xb8\xa9\xf9\x07\x70\xed\xde\x9d\x86\xfd\x2e\xf3\x4f\x22\xbf\x7b\x0c\xf9\xdd\x67\x40\xbe\xff\xf4\xe4\xb3\xff\x9c\xfc\x15\x98\x46\x12\xaa\x2e\x73\x3e\x69\x00\x0c\x95\x08\x2f\x9e\x62\x25\xd8\xc5\xe5\x4a\x5c\x3c\xdb\x95\xf8\xc7\x56\x0f\xbf\x91\xfc\x18\xea\xdb\xc7\x50\xdf\x3e\x4a\xfd\x61\x06\x28\x56\xcd\x55\xb4\x9f\xee\xc7\x5f\xfd\x25\x96\x67\xf9\xe0\xf8\x2b\xe6\x48\x34\xfd\xf9\xf6\xa9\x1f\x1c\xff\x7f\x79\x70\xfe\xf5\xc5\x66\x6d\x7f\x75\xb5\x1f\x7e\xf8\xc9\xc3\x6f\x7e\x7f\x70\xff\xdb\x83\x5f\xff\x38\x99\xb1\x27\x10\xc6\x9e\x9e\xaf\x13\xff\xd9\x5f\x3b\x51\x2b\xa0\x19\xea\x3c\x6a\xfd\x35\x00\x08\x8b\xea\x72\x8f\x0a\x00\x00")
+
+func dataForumindexHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataForumindexHtml,
+		"data/forumindex.html",
+	)
+}
+
+func dataForumindexHtml() (*asset, error) {
+	bytes, err := dataForumindexHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/forumindex.html", size: 2703, mode: os.FileMode(420), modTime: time.Unix(1792310923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _dataIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\xdb\x6f\xd3\x48\x17\x7f\x4e\xa5\xfe\x0f\x07\x47\x1f\xdf\xf7\x49\xb5\xc7\xb9\xb4\x4d\x1d\x27\x12\xdb\x96\xdd\x4a\x85\x22\x08\xbb\xec\xbe\x54\x13\x7b\x92\x98\x3a\xb6\x65\x4f\x9b\x86\x6a\x1f\x60\x6f\x40\xbb\x50\xc4\x4d\x08\x50\xa9\xf6\x42\x57\x5c\xb6\x14\x56\x1b\xa0\xc0\x3f\xd3\x38\xe9\x13\xff\xc2\x6a\xc6\x76\x1a\x48\xd9\x74\x1e\xe2\x78\x7c\x7e\xbf\x73\x9b\x73\xe6\xa8\x87\x26\x66\xc6\x0b\x5f\x9f\x98\x84\x0a\xad\x9a\x70\xe2\xf4\x67\xd3\x53\xe3\x20\x88\x08\x7d\x95\x1a\x47\x68\xa2\x30\x01\x67\xbe\x28\x1c\x9b\x86\x84\x24\x43\xc1\xc5\x96\x67\x50\xc3\xb6\xb0\x89\xd0\xe4\x71\x01\x84\x0a\xa5\x8e\x82\x50\xad\x56\x93\x6a\x29\xc9\x76\xcb\xa8\x70\x12\x2d\x32\xae\x04\x03\x87\x7f\x45\xda\x85\x94\x74\xaa\x0b\xf9\xc1\x01\x95\x6b\x5c\xac\x9a\x96\x97\xdb\x87\x27\x31\x36\x36\x16\xc0\x03\x61\x82\x75\xf6\x2c\x62\x8f\x40\xc5\x25\xa5\x00\xe3\x29\x08\x15\x8b\x9e\xe4\x61\x17\x17\x71\xc2\xa3\x92\x66\x57\x51\xb2\x88\xb0\xab\x55\x8c\x05\xe2\x22\x01\x10\xc3\x51\x83\x9a\x24\xdf\x7e\xfa\xaa\x79\xff\x2e\x88\x00\x27\xec\x1a\x71\x89\x0e\xc5\x3a\x4c\x18\x9e\x36\x7f\xee\x10\x1c\x09\x21\x2a\x0a\x84\x07\x07\x06\x07\xd4\x2a\xa1\x18\x2c\x5c\x25\x39\x61\x8e\xd4\x6b\xb6\xab\x7b\x02\x68\xb6\x45\x89\x45\x73\x42\xc0\x17\xaa\xe8\x12\xd5\x89\xa7\xb9\x86\xc3\x1c\xee\x91\x86\xa1\x53\x14\x97\x49\xc2\xa3\xbd\xb8\x32\xb1\x88\x8b\xa9\xed\x76\xa1\x22\xf3\xce\xa4\xa4\x74\x2f\x02\xcf\xd3\xca\xbe\xe2\x05\x82\xab\x80\x2d\x1d\xc6\xed\xaa\x47\xac\x73\x70\x7a\x8a\xef\xf5\x52\x68\xb6\x53\x77\x8d\x72\x85\x76\xb1\x24\x65\x39\x21\x26\xe5\xc4\x68\x07\x3e\x65\x69\x52\x88\xf5\x68\xdd\x24\x40\xeb\x0e\xc9\x09\x94\x2c\x52\xa4\x79\x1e\x4b\x52\xac\x68\xeb\x75\x58\x2a\xd9\x16\x15\x4b\xb8\x6a\x98\x75\x05\xbe\x24\xae\x8e\x2d\x9c\x3d\x3a\x73\xbc\x20\x9e\x9a\xfa\x66\x52\x81\x44\xd2\x59\xcc\x1e\x3b\x72\xf2\xf3\xa9\xe3\x0a\xc8\x59\xcd\x36\x6d\x57\x81\xb8\xcc\x57\xb6\x88\xb5\xb9\xb2\x6b\xcf\x5b\xba\x02\xf1\x12\x5f\xd9\x6f\x07\x07\x62\x46\xb5\x0c\x4b\x45\xdb\xd5\x89\xab\xc8\x7c\xc7\x34\x60\xa9\x8a\xdd\xb2\x61\x89\xd4\x76\x14\xc8\x38\x8b\x7c\x5f\x72\x70\x99\xc0\x92\x83\x75\xdd\xb0\xca\x0a\xa4\x9d\xc5\x2c\x04\xc8\x40\x30\xe1\x2c\x42\x7c\x92\x2f\xf0\x6c\xd3\xd0\x39\x2a\x88\x24\x2c\xed\x19\x20\x06\xa6\x71\xd1\xa3\x47\xb3\xd0\x61\x1c\xd9\x97\x51\xd7\x75\x9d\x90\x3d\xc6\xb8\x85\x17\x86\x20\x1e\xc6\x74\x08\xe2\xc4\xd2\xbb\xcc\xca\xec\x91\x04\x04\x1c\x18\x19\x96\x05\xcd\x24\xd8\x55\xa0\x68\xd3\x4a\x16\x6a\x86\x4e\x2b\x0a\x8c\x0d\xff\x27\x0b\x81\xcf\x0a\xe0\x79\x6a\x47\x6f\xa1\x19\x72\x18\x82\x38\x2b\x17\xe2\x0e\x41\xbc\x64\xdb\x94\xb8\xb0\xf4\x81\x5c\x92\xc9\x01\x17\x34\xed\xb2\x61\x95\x6c\xb7\x0a\x4b\x2c\x95\x22\x36\x8d\xb2\xa5\x80\x46\x2c\x4a\x5c\xc6\xa5\x22\x9e\x6e\x96\x77\xd4\x29\x42\x96\xe7\x05\xd3\xb0\xe6\x72\x42\x3c\xc5\x97\x00\x1f\xbe\x32\xb1\x80\x04\x0c\x3d\x27\x04\xf6\xf0\x5d\xdd\x58\xc8\xb3\x1f\xe0\xbc\x39\x41\x37\x3c\xc7\xc4\x75\x05\x0c\xcb\x34\x2c\x22\x16\x4d\x5b\x9b\xcb\x0a\x79\x35\x28\xa1\xee\x73\x76\x16\x2f\xe0\x60\x97\x31\x01\x00\x94\x6d\xbb\x6c\x92\x59\xac\xcf\x6a\xa6\x41\x2c\x0a\x39\x10\x34\x2c\x3a\xf3\x45\x31\x2d\x67\x52\x69\x39\x9d\x96\xe5\xd1\xd1\x91\xd1\x91\x8c\x90\xfd\x18\xe2\x99\x36\x07\x64\x46\xc6\x52\xc3\xc3\x23\x99\xa4\xdc\x2b\xc3\x03\x0f\x39\x18\x4d\x66\x7a\xbe\x55\x08\xab\x1a\xc8\xc1\x98\x9c\xe5\x81\xe2\xa6\x31\x1f\x0f\x89\x22\xb4\x37\xff\xf6\x9f\xac\x34\x7f\x7d\xe1\xdf\x5f\x6f\xbe\x7c\xd7\xbc\x76\x19\x44\x91\x97\xcf\xbf\xf9\x35\x38\xe0\xb9\x5a\x4e\x40\x88\x1d\x62\xac\x27\xa5\x40\x9d\x57\xb7\x74\x43\xc3\xac\xa1\xf0\x0e\x17\x7c\x45\x5e\xc5\xae\xcd\x62\xdd\x93\xce\xf2\x02\xec\x98\xa0\x22\x1e\xe5\xe0\xb7\x92\xcc\x47\x1d\xe7\xbf\x5e\xa7\xcb\x81\x8a\x2a\x49\x8e\x09\xd2\x14\xe4\x84\xe5\xca\xc2\x0b\xbc\x9a\x55\x1c\x36\x5a\x49\x42\x2c\x1d\xd4\xb5\xad\x72\x87\x8a\x9d\x0b\xbe\xa1\x22\xcc\x69\x98\xae\x20\xbd\x9c\x25\x3c\xf6\x9c\x29\xa6\x56\x52\xf9\xd6\x77\x4f\x76\x6f\x6f\xf8\xb7\x36\x9b\x2b\xaf\x54\x54\x49\x05\x1f\xe6\x4d\xfe\x8c\xc5\x54\xd3\xc8\x77\x34\x96\x0c\x5d\x4c\xa4\x65\x29\x68\xff\xed\xf3\x37\xda\xef\x56\xc1\xbf\xfd\x60\xf7\xce\xea\xce\xcb\xe5\xe6\xfa\x0d\xa6\x54\x45\xa6\xf1\x49\x70\x2a\x19\x82\x5b\x17\x2e\xb5\xd6\x9e\xef\x6c\x3f\x68\xff\xf9\xa2\x3f\x2a\x13\xa2\x26\x66\x0a\x47\xfa\x4b\x0f\x87\xd2\xfe\xa5\x65\xbf\xd1\xd8\x5d\xff\xcb\x6f\x34\xfa\xa2\x12\x89\xc8\xad\xe5\x67\xbb\x77\xbf\x6f\x9f\xbf\xd1\xba\xbb\xf6\xbf\xe9\x99\xe9\xff\xf7\x83\x66\x22\x9f\xfc\x5b\x9b\xad\x37\xd7\xfc\x46\xa3\xf5\x7a\xbb\xb9\x7a\xb1\xfd\x74\x83\x5d\x2d\x2c\xac\xdd\x04\x2a\x8a\x82\xcb\xc2\xbf\xd3\x78\x1d\xdc\x3f\x07\x89\x7d\x3a\x52\xd4\x68\xf8\x17\xaf\x46\xb8\x3e\x8e\xa5\x3b\xa8\xc7\x0f\x5b\x6f\xaf\x34\x2f\x6f\xf8\xdb\x8f\xfa\xa1\x46\x42\x4c\x20\x7d\x40\x4d\xa9\x08\xe5\x6f\xac\xb7\xae\xfc\xb1\xd3\xb8\xde\xe3\xfc\x3e\x3e\x45\xb9\x6d\xbe\x79\xd6\x7e\xf8\xe3\xc1\x54\x25\x23\x9f\x76\xd7\x9e\xef\xbc\x5c\x3d\x18\x68\x38\xca\xf0\xfb\xed\xcd\xf7\xdb\xbf\xf8\x37\x37\x5b\x0f\xce\xf7\x05\xc9\x9d\xe4\xfe\xd4\xbc\xba\xe5\x6f\xdd\xd9\x7d\x7b\xa7\x1f\x28\x95\xe8\xf8\xb4\xdd\xdc\x6a\x30\xe8\xca\xad\x7e\xa0\xd1\xd1\x08\xf4\xc3\xa3\xe6\xcf\xbf\xef\xbc\xb9\xde\xbe\xb0\xf5\x89\xa3\xd3\x53\xd6\xc4\xe2\xc3\x5b\xcc\x5f\xfb\xad\x75\x6f\xb9\xf9\x74\xc5\xbf\xf9\xa2\x75\xe9\xa2\x7f\xef\xb1\xf2\x51\xcb\x28\xd9\xee\x7c\x55\x72\x2a\x8e\x00\x14\xbb\x65\x42\x73\xc2\x6c\xd1\xc4\xd6\xdc\xc1\x7b\x49\xd1\x0d\x06\x8e\xb0\x43\x71\x83\x22\x43\x82\x8b\x2d\x68\x2f\x5d\xc3\x5c\x44\xad\xe2\x8f\xb5\x76\x4d\x8d\xe1\xa4\xa9\xf3\x51\x49\xb2\x08\x15\xf2\xdd\x53\x56\xd7\x24\xc8\x82\x12\x32\xc2\x61\xab\xe8\x39\x59\x38\xcc\xe6\x25\xd8\x1b\x90\x0e\xa2\x49\x0b\x86\x28\xf6\x14\xf2\xdd\x13\x55\xe0\x70\x2c\x16\xb9\xda\xf5\xaf\x13\x05\xd4\x71\x5f\x45\xec\xe6\x0d\xae\x62\x5a\x65\x19\xfa\x27\x00\x00\xff\xff\xde\xf4\xf5\x92\xc0\x0b\x00\x00")
 
 func dataIndexHtmlBytes() ([]byte, error) {
@@ -132,6 +243,86 @@ func dataIndexHtml() (*asset, error) {
 	return a, nil
 }
 
+var _dataLoginHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x5f\x8f\xdb\x44\x10\x7f\xbf\x4f\xb1\x5d\x89\x5e\x2b\x71\xd9\xcb\x05\x09\x7a\xb1\x8d\xc4\x5d\x25\x90\x0a\x54\x10\x44\x79\x8a\xd6\xde\x71\x6c\x6a\xef\x9a\xdd\x75\x9c\xf4\x89\x1e\x7f\x7a\xad\x28\xad\x54\x78\x28\x1c\x88\x4a\xa5\x8d\xa0\xa5\x12\x08\xb5\xf4\x7a\xf0\x65\x1a\x27\x7d\xe2\x2b\xa0\xb5\x9d\x5c\x2e\x97\xfe\xe1\x1e\xce\xeb\x9d\x99\xdf\xfc\x7e\xe3\x99\x89\x75\x64\xf3\xdd\x8d\xd6\x47\xa7\x4f\xa2\x40\xc7\x11\x3a\xfd\xc1\x1b\xa7\xde\xda\x40\x78\x85\x90\x0f\x1b\x1b\x84\x6c\xb6\x36\xd1\x99\x37\x5b\x6f\x9f\x42\xf5\xda\x2a\x6a\x49\xca\x55\xa8\x43\xc1\x69\x44\xc8\xc9\x77\x30\xc2\x81\xd6\xc9\x3a\x21\x59\x96\xd5\xb2\x46\x4d\xc8\x0e\x69\xbd\x47\x7a\x06\xab\x6e\x82\xab\xe3\x8a\x9e\x89\xac\x31\xcd\xb0\xb3\x64\x19\x0b\xea\xc5\x11\x57\xf6\x02\x98\xfa\x89\x13\x27\xca\xe8\xc2\x17\x28\x73\x96\xac\x18\x34\x45\xc6\x77\x05\x3e\x49\xc3\xae\x8d\x37\x04\xd7\xc0\xf5\x4a\xab\x9f\x00\x46\x5e\xf9\x66\x63\x0d\x3d\x4d\x4c\x6c\x13\x79\x01\x95\x0a\xb4\x9d\x6a\x7f\xe5\x35\x8c\x88\xb3\x64\xe9\x50\x47\xe0\xe4\x57\xae\x8e\x6e\x3e\x7c\xfc\xcf\x8d\xfc\xfc\x3d\xb4\x82\xd0\xfb\x9a\x76\xa0\xae\xb4\x39\xab\xe2\x4c\x54\x1d\xe5\x0f\x1e\xe4\xdb\x57\x86\x97\x06\xf9\xa3\x5f\xc7\xbf\x3d\x1c\xfe\xf0\xbd\x45\xca\xf8\x8a\x0d\xa7\x31\xd8\xb8\x03\x1c\x24\xd5\x42\xce\x90\xd8\x0c\x95\x97\x9e\x3b\x82\xce\x34\x6a\xaf\x94\x89\x5d\xaa\x00\x05\x12\xfc\x52\xb0\x5a\x27\xc4\x75\x55\x4d\x51\x49\x5d\x5a\x57\xba\xe6\x89\x98\xac\xb9\xa4\xf4\x26\x95\x68\x57\xb0\x3e\x0a\x99\x8d\x79\xb7\xed\x0b\x99\xc6\x18\x79\x11\x55\xca\xc6\x49\xa7\xdd\x0d\x21\xd3\x81\x04\xca\x30\x12\xfc\x2c\xf4\x99\xc8\xb8\x8d\x43\xff\x18\x74\x81\xeb\xda\x59\xe8\x6f\x08\x06\xb6\xbd\xf6\xea\x71\x24\x41\xa7\x92\x23\x9f\x46\x0a\x9a\xa6\xac\x2c\xec\x16\xc8\x59\x32\xc5\xcc\x92\x59\x83\xa7\x67\x0c\xc8\x8b\x50\x36\xb1\x56\xb7\xdc\x8f\x70\x01\x11\xd3\x90\xb7\x55\xea\x79\x00\x0c\x23\xa5\xfb\x11\xd8\x98\x85\x2a\x89\x68\x7f\x1d\x71\xc1\x61\x2e\xd2\x6f\x7b\x88\x46\x7a\x1e\x90\x46\x20\x75\x5b\x86\x9d\x40\x1b\x4b\x52\x80\x57\xb8\x31\x28\x45\x3b\x80\x1d\x8b\x24\xf3\xb6\x48\x78\xd4\x74\xe6\x94\x6f\x09\xe4\x6a\x1e\x81\xaf\xf7\x43\x9e\x62\xa5\x0b\xf2\xb4\xcd\xa7\xc2\xce\xf0\xd6\x56\xfe\xe3\x4e\xbe\x35\x18\x7d\xf7\x79\xfe\xe7\x95\xf1\xed\xed\xe1\xf5\x41\xfe\xfb\x8d\x7c\xe7\xe2\xf8\xc2\x2f\xc3\x4b\x83\xf1\xfd\x3f\xc6\x7b\x77\xfe\x7d\xf4\xd5\xf8\xde\xfd\xd1\xd6\x5f\xc3\x0b\xbb\xf9\xdd\x9b\x4f\xae\xfd\x9d\x7f\xfd\xb3\x45\x68\x95\x99\xb0\xb0\x7b\xe8\x31\xa3\x3b\xe6\x33\x75\x9c\x0a\x3d\xe0\xe2\xc6\x87\x2e\xda\x01\x72\x5d\x65\xae\x83\xc6\xe4\xb6\xa7\xd6\xf0\x81\xee\xb6\x48\xd0\x58\x98\xd3\x8d\xdb\xde\x1c\xa4\x57\xb1\x28\x09\x98\x39\xc2\x87\xcb\x16\x72\x5f\x60\x27\xdf\x1a\x3c\xd9\xf9\x74\x7c\xeb\xfc\xf0\x8b\xed\xd1\xf5\xdd\xe1\xde\xb7\xf9\xc5\xcb\xe3\xcf\xf6\x46\xbb\xb7\x47\xbb\x77\xf3\x9d\x3b\xf9\xb5\xcb\x8f\xf7\x76\x0e\xc8\x9f\xf4\x55\x25\x30\x12\x9d\x90\x63\x67\x11\x27\x5f\xc8\x18\xc5\xa0\x03\xc1\x6c\x9c\x08\xa5\x31\xa2\xa9\x16\x9e\x88\x93\x08\x34\xd8\x58\xf8\x3e\xae\xa6\xaf\x84\x29\x88\x17\x47\x13\xdb\x3e\x75\x9a\x79\xf5\x69\x37\x78\x91\x99\x0f\x95\xba\x71\xa8\x6d\x9c\x64\xcc\x8b\x80\x4a\x64\xa3\x7a\x93\x7e\x4c\x7b\x26\xc1\xb1\xe5\xb9\xe0\xe5\x97\xd1\x72\x39\x32\x15\xdd\xe7\x5e\x0b\x0e\x52\x0a\xb9\x7c\xbc\x79\x70\xd4\x10\xf5\x4c\x6f\x1a\xdd\xb1\x0b\xb2\x96\x04\xc9\xeb\xb1\x60\x76\x24\x3a\x9d\x90\x77\x8e\xd2\x38\x69\x56\x2e\x05\x87\xe2\xa2\x38\x55\x8c\xfb\xa0\xf6\xef\x02\xaa\x02\xbb\xc8\x69\x0a\x15\xf2\x24\xd5\x48\xf7\x13\xb0\x71\x10\x32\x06\x7c\x52\x16\x53\x06\xe3\x8b\x51\x97\x46\x29\xd8\x98\xd6\xdd\x35\xaf\xc1\xaa\x6d\xf4\xf4\x40\x09\x3e\x48\x90\xd3\xb8\x67\x2e\xab\x62\x25\x4d\x15\xed\xef\xa3\x82\xaf\x0e\x99\xdd\x28\xfe\xca\x9c\x33\x1f\x5a\xfa\x45\x3b\x6b\xea\x16\xcb\x54\x4b\xf3\x2f\x70\xac\x88\xba\x10\x21\x5f\x48\x1b\xa7\x0a\xa4\x61\x54\x7d\x4b\x67\xf4\xcd\x20\xdf\xbe\x3f\xbc\x7a\x79\xdd\x22\x85\x9b\x63\x11\x1d\x98\x38\xe6\x1c\xa8\x43\xd1\xb7\x95\x98\x09\x48\xd9\xd8\x73\x90\x8b\x7a\x4a\x85\xe7\xc0\xc6\x8d\xd5\x69\xef\x24\x3d\x94\xb4\x7d\x09\x18\x69\xea\x86\x9c\x41\xcf\xc6\xf5\x69\x71\x8c\x30\x8b\x68\xb3\xa9\x49\x21\x82\x4c\x24\x1d\x1e\xb8\x17\xd0\x9c\x50\xa5\x32\x21\x59\x63\x22\x7a\x78\xef\xcb\xd1\x4f\xe7\x9f\xa7\x78\x12\x56\xaa\x9c\x07\xa9\x4a\xb1\xef\xf4\xa2\x1a\xff\x97\x34\x14\xbb\x19\x72\xb3\xd5\xa9\x44\x94\x85\x4c\x07\x36\xae\xaf\xae\xbe\x84\x67\xf4\x1e\xe5\xae\x4a\x9a\x33\x4a\xdc\x54\x6b\xc1\xa7\x5c\x38\x4a\xb8\x87\x2b\x65\xe5\x04\x4c\x24\xcc\x0c\xc5\xf4\x0b\x68\x99\xce\xf1\x76\x2c\xa5\xa5\xe0\x1d\xa7\xdc\x4b\x16\xa9\x5e\x2d\x52\x66\x7a\xb6\x2a\x62\x06\x67\xf2\xba\x78\x71\x2f\x7e\x98\x5f\x6a\x67\xc9\x22\x81\x8e\x23\x67\xe9\xbf\x01\x00\x84\x36\xfa\x13\x5b\x09\x00\x00")
+
+func dataLoginHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataLoginHtml,
+		"data/login.html",
+	)
+}
+
+func dataLoginHtml() (*asset, error) {
+	bytes, err := dataLoginHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/login.html", size: 2395, mode: os.FileMode(420), modTime: time.Unix(1792311682, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataLoginformSeccodeHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\xdd\x6e\xdb\xc8\x15\xbe\xd7\x53\xcc\x0e\xda\x95\x0d\xd4\xa2\x7e\x92\x66\x2d\x91\x2c\xb6\x76\x80\x2e\x9a\xb6\x8b\xc6\x8b\x6e\xaf\x84\x21\x79\x24\x4e\x4d\x72\x18\xce\x48\x94\xb6\x58\x20\x59\x6c\x76\xbd\x69\x9d\xf8\x22\x69\xd0\xc4\x6d\x9a\xa2\x6d\x1c\xb7\xb1\x83\x5c\xc4\x86\x5b\xa7\x2f\x23\x4a\xf6\x5b\x14\xc3\x3f\x49\xb6\x15\x3b\x08\x50\xc0\x30\xc9\x99\xf3\x9d\xdf\xef\x9c\x19\xa9\x1f\x2c\xff\x62\x69\xe5\xd7\x9f\x5e\x45\xb6\x70\x1d\xf4\xe9\x67\x3f\xbe\xf6\xc9\x12\xc2\x0b\x8a\xf2\xab\xda\x92\xa2\x2c\xaf\x2c\xa3\xcf\x7f\xb2\xf2\xb3\x6b\xa8\x52\x2a\xa3\x95\x80\x78\x9c\x0a\xca\x3c\xe2\x28\xca\xd5\x9f\x63\x84\x6d\x21\xfc\xba\xa2\x84\x61\x58\x0a\x6b\x25\x16\xb4\x95\x95\x5f\x2a\x3d\xa9\xab\x22\xc1\xe9\xeb\x82\x98\x40\x96\x2c\x61\x61\xbd\xa0\xca\x1d\xd4\x73\x1d\x8f\x6b\x67\xa8\xa9\x2c\x2e\x2e\x26\xe8\x58\x16\x88\xa5\x17\x54\x17\x04\x41\x52\x76\x01\x6e\x74\x68\x57\xc3\x4b\xcc\x13\xe0\x89\x85\x95\xbe\x0f\x18\x99\xc9\x97\x86\x05\xf4\x84\x22\xb1\x0d\x64\xda\x24\xe0\x20\xb4\x8e\x68\x2d\x7c\x84\x91\xa2\x17\x54\x41\x85\x03\xfa\xe8\x8f\xff\x8e\x0e\x1f\xa0\x05\x84\xae\x0b\xd2\x86\x0a\x17\xf2\x9d\xc7\xef\x0a\xaf\xa0\xe1\xfe\xfe\x70\xed\x5e\x74\x67\x6b\xf8\x9f\x7f\x1e\xed\x1c\x44\x7f\x7a\xac\x2a\x09\x32\xf5\xc3\x23\x2e\x68\xb8\x0d\x1e\x04\x44\xb0\x60\xc2\xfc\x32\xe5\x66\xe7\x8b\x0f\xd0\xe7\xb5\xd2\xa5\xc4\xa4\x41\x38\x20\x3b\x80\x56\x12\x2a\xaf\x2b\x8a\x61\xf0\x12\x27\x01\x31\x48\x85\x8b\x92\xc9\x5c\xa5\x6a\x28\x89\xb4\x92\x86\x6b\x30\xab\x8f\xa8\xa5\x61\xaf\xdb\x74\xc1\x35\x40\x1a\x71\x08\xe7\x1a\xf6\xdb\x4d\x87\xb5\xdb\xd4\x6b\x63\xc4\xbc\x55\xe8\x5b\x2c\xf4\x34\x4c\x5b\x73\xd0\x05\x4f\x94\x56\xa1\xbf\xc4\x2c\xd0\xb4\xea\x95\x79\x14\x80\xe8\x04\x1e\x6a\x11\x87\x43\x43\xa6\xd3\xa2\xdd\x58\x6f\xe8\xe7\x0a\x43\x7f\x72\xc3\x14\x63\x4b\xc2\x45\xa1\x8f\x42\x64\x3a\x99\x44\xba\xe3\x7a\x27\x16\x0c\x17\xc7\x6a\x5d\x42\xbd\xa6\x0b\x9c\x93\x1b\x6d\xc8\x64\x4e\x6d\x34\xaf\xd9\x3f\xad\x85\x93\xdb\x0e\xe9\x43\x20\xe3\xa2\xde\x78\xd3\xae\x65\xea\x5b\x8e\x21\x17\xc0\x8d\x8d\x24\x41\xc5\x56\xc6\xba\x46\xf7\xb7\x86\x6b\x7b\x49\x69\x55\x05\xdc\x38\x99\x35\xbd\xa0\xb6\x58\xe0\x22\x17\x84\xcd\x2c\x0d\xfb\x8c\x0b\x8c\x48\x47\x30\x93\xb9\xbe\x03\x02\x34\xcc\x5a\x2d\x9c\x96\x34\x76\x20\x89\x24\x7e\x95\xd8\xd4\x40\xe6\x8a\xe9\xc8\xb4\xf3\x8e\xe1\x52\xa1\x61\x3f\xb4\x4c\x07\x48\x80\x34\x54\x69\x90\xdf\x90\x9e\x34\x30\x57\x3c\x01\x2e\xfe\x00\x15\xcf\x70\xfa\x2d\xcb\xcc\x83\x20\x60\x41\x71\xbe\x31\x5d\x41\x44\x4c\xd9\x84\x1a\x4e\x48\x51\xf2\x6d\xff\x47\x2e\xb3\xb4\x94\x11\x1f\x12\xd7\x6f\xa4\x22\xb1\x0f\xf1\x42\xfc\x96\x7a\xdc\x07\x3e\x5e\xb3\x09\xb7\xb5\x3c\xdd\x13\xe5\x34\xd3\x92\x53\xcf\xef\x08\x24\xfa\x3e\x68\xd8\xa6\x96\x05\x5e\x96\x29\x99\x19\x09\xc7\xa8\x4b\x9c\x0e\x68\x98\x54\x8c\xaa\x59\xb3\x52\xd6\xcf\x06\x06\xd0\x82\x00\x82\x1c\xf7\xd6\xa6\x28\xa5\x6d\x31\xe1\x5a\xd0\x72\x25\x15\x04\x31\xe2\x7e\x14\x81\xfc\x67\xeb\x05\x95\xfb\xc4\xcb\x84\xe2\x88\x9b\xdc\x31\x85\x94\xe5\xe0\x80\x29\x52\xfb\xf1\x56\x8b\x82\x63\x61\xc4\x45\xdf\x01\xc9\x2e\x46\x44\x1d\x39\xd0\x12\x0d\x8c\x42\x6a\x09\x5b\xc3\x97\x2e\x4f\xf2\x40\xca\x67\x4c\x2b\xa8\xcc\x97\x19\xce\x22\xe8\x70\x08\xa4\xee\x8c\x83\xd1\xc6\xba\xaa\x24\x22\xa7\x65\xa9\x85\xf5\xcf\x3e\x59\x9e\x29\x00\x2e\xa1\x0e\xd6\xaf\xca\xc7\x84\x90\x92\xc4\x10\xbf\xf9\xc4\x93\xcf\x38\x68\x61\xe9\x53\xb9\x96\xe3\x2f\xcb\x74\xee\x57\x1c\x47\xf6\x95\xd1\xf9\x8c\x1e\xe0\xf4\x0b\xd0\x70\xad\x3c\x1e\x00\x3d\xe4\x37\x5b\x01\x60\x24\x88\x41\x3d\x0b\x7a\x1a\xae\xe4\x95\x93\xa5\x51\x15\x21\xc7\x95\x12\x97\x41\xc9\x8a\xa2\x58\xb4\x7b\xa1\xaa\xa9\x0e\x31\xc0\x41\x2d\x16\x68\xd8\x27\x9c\x87\x2c\xb0\x6a\x59\x9e\xa3\xdd\x6f\x46\x7f\xb9\x55\x57\x95\x58\x48\x9f\x11\x71\x06\x4b\xaa\x75\x52\x49\x9a\x8a\xb1\xd0\x45\x63\x7c\xef\xd0\xa2\x9d\xef\xa2\xdb\x5b\xc3\x7b\x1b\xc7\x0f\x77\xea\x13\xbe\xa7\x64\xcc\xa9\x75\xa3\x03\x5c\x16\x99\x66\xfc\xca\x08\x58\xad\xd4\x32\xf7\xc7\x32\x72\xf6\x98\x36\xf1\xda\x10\x4f\xfc\xef\xcd\x15\xcf\x54\x52\x9c\x2f\xc5\x45\x42\x3a\x2a\xcf\xa3\xdf\x66\x62\xc4\xe3\x21\x04\xcd\x80\x85\xb9\x58\xdc\x02\x25\x8b\x72\xdf\x21\x7d\xad\x58\x6c\x7c\x89\xc0\xe1\xf0\x2e\x18\x8f\x79\x50\x6c\x7c\x79\xba\x31\xca\x78\x2a\x0b\x73\xc3\xcd\xed\xa3\x9d\x37\xa3\xc3\x9d\xa3\xdd\xbd\xe8\xbf\x87\xa3\x07\x7f\x9f\x9f\xd9\x07\x15\xac\x0f\x77\xd7\x07\x07\xaf\x46\x8f\xbe\x8e\x36\xd6\xa3\x17\x0f\x67\x8a\x56\xb1\x3e\x5a\xdb\x93\x7f\xe7\x8a\xd6\xa4\xe8\xeb\xc1\xc1\xab\xe8\xdb\x83\xd1\xfd\x27\x52\xf7\x93\xbb\xd1\xfe\x57\x33\x01\x97\xb0\x3e\xfc\x6a\x2b\xba\xfd\x7a\xb0\xff\x62\xb0\x7f\x73\x70\xb8\x7e\x74\xf3\x56\xb4\xbf\x76\xbe\xa9\xcb\x31\x72\xb0\xbf\x3d\x38\x38\x38\xda\x79\x3a\xda\x79\x38\xdc\x3c\x90\xb0\x3f\xff\x2e\xba\xb7\x37\x13\xf6\xc3\x18\x36\xdc\xbc\x19\xfd\x61\x73\xf8\xaf\xbf\x8e\x1e\x7d\x7d\xfc\xb7\x8d\xe3\x7f\x7c\x13\x6d\xac\x8f\x9e\xbd\x9c\x09\xbb\x82\xf5\xe3\xe7\x6f\x8e\x9f\xbf\x1e\x7e\xf7\x6c\x74\xfb\x99\xc4\x6f\xdc\x8d\x1e\x3f\x1e\x1c\xae\x0f\x1f\xbc\x9c\xf6\x33\x9b\x25\xef\x42\xf0\xf1\x28\x3c\xc9\x87\x7c\x8a\xa6\x7c\xa8\x4b\x3a\x9c\xd5\x10\xa3\x17\xf7\x87\x4f\xd7\xea\x33\xda\x78\x72\x70\x25\x26\x4e\xdb\x7c\xff\xc9\x75\x4e\x57\xc7\x47\x88\xb4\xca\xc1\x34\x99\x05\x4d\xf3\xfa\xc7\x58\xcf\x47\xee\xbb\xcd\xb3\x54\x47\x17\x02\xda\xea\x27\x9a\x8e\xb7\x7f\x7f\xb4\x7b\xeb\x02\x43\x6d\xfa\xc8\x4c\x35\x4d\x1d\xb7\x52\xdf\x39\x27\x6d\x0a\x73\x99\x45\xad\x1c\x97\xdc\x1b\xea\xf5\xfc\x0a\x39\xd6\x31\x85\x4a\xdc\x4e\x6a\x30\xb5\x14\x47\x92\xda\x4b\x8a\x76\x56\x3d\x12\x4a\x50\x17\x16\x5c\x66\x41\xdd\xa2\x5c\x8e\x47\xab\x11\xcf\xb6\x7a\xa5\x5c\xf6\x7b\x79\xad\x44\x4f\x20\xbf\x87\xba\xee\xa9\x62\x4d\x54\xa4\x3b\x5d\x12\xea\xb6\xe5\x24\x74\xa8\xb9\xaa\xe1\x8e\x6f\x11\x01\xa9\xc0\x5c\xd1\xbc\xfe\x71\x71\x3e\x1f\xa3\x95\x72\x19\x23\x1b\x68\xdb\x16\x09\x43\x78\x60\x6a\xd8\xa5\xdc\xcc\x2f\x4f\x29\x32\xbe\x17\x25\xba\xb4\x8f\x2a\xd5\x2b\xb5\x78\x81\x5a\x32\xef\x9a\x34\x9b\x39\x2c\x3d\x25\x8e\xc8\x4e\xc0\x94\x1d\x17\xef\x26\xe4\x1a\x21\x32\xc2\x72\xce\xa0\x09\x5f\xbf\x8f\x27\xe8\xf4\xa1\x67\x70\xbf\x31\xe6\x48\x61\x92\x5f\x26\x63\xab\x14\x04\xcd\x8f\xf4\x69\x0a\x99\x36\x98\xab\x06\x1b\xa7\xd9\x37\x33\x62\x8c\x91\x49\x7d\x4f\x69\x9a\xae\x43\xca\x9c\xea\xe5\xc5\x6a\x59\x26\x13\x29\xfa\xd1\xb7\xdb\xd1\x9d\xad\xec\xb2\x1d\x3b\xf5\xff\xca\x80\xd1\x11\x82\xe5\x17\x3d\xdf\x43\xbe\x67\xe2\x34\xe6\xe4\x8e\x9b\x85\x39\x71\xed\xcd\x83\x10\x41\xe7\xc4\x4c\xd0\x55\x2e\x02\xe6\xb5\xd3\x5f\x85\xaa\x92\x7e\xaa\x4a\x62\xe9\x9c\xb8\xb2\x87\xbc\x0f\x9f\x5e\x7d\xdb\x43\xfe\xc4\xd3\x0b\xaa\x62\x0b\xd7\xd1\x0b\xff\x1b\x00\x34\x54\x8a\x5b\x8e\x0f\x00\x00")
+
+func dataLoginformSeccodeHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataLoginformSeccodeHtml,
+		"data/loginform-seccode.html",
+	)
+}
+
+func dataLoginformSeccodeHtml() (*asset, error) {
+	bytes, err := dataLoginformSeccodeHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/loginform-seccode.html", size: 3982, mode: os.FileMode(420), modTime: time.Unix(1792311873, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataLoginformHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x6b\x6f\x13\xc7\x1a\xfe\xee\x5f\x31\x8c\xce\xc1\x89\x74\xe2\xb1\x63\x38\x9c\xc4\xbb\x7b\xa4\x26\x48\x45\xa5\x2d\x6a\x83\x4a\x3f\x45\xb3\xbb\xaf\xbd\xdb\xec\xce\x2c\x3b\xe3\x6c\x4c\x85\x04\x88\x4b\xa0\x0a\xe4\x03\x14\x15\xd2\x52\xaa\xb6\x04\x5a\x02\xe2\x03\x89\xd2\x86\xfe\x19\xaf\x9d\xfc\x8b\x6a\xf6\x62\x3b\x17\x73\x11\x52\xa5\x28\x33\x3b\xf3\xbc\x97\xe7\xbd\x79\xb4\x43\xd3\x9f\x4e\xcd\x7c\x79\xea\x38\x72\xa4\xef\xa1\x53\xa7\x3f\x38\x79\x62\x0a\xe1\x31\x42\xbe\xa8\x4e\x11\x32\x3d\x33\x8d\xce\x7c\x38\xf3\xf1\x49\x54\x29\x95\xd1\x4c\x48\x99\x70\xa5\xcb\x19\xf5\x08\x39\xfe\x09\x46\xd8\x91\x32\x98\x24\x24\x8a\xa2\x52\x54\x2d\xf1\xb0\x41\x66\x3e\x23\x0b\x4a\x57\x45\x09\x67\xdb\x31\x39\x20\x59\xb2\xa5\x8d\x8d\x82\xa6\x6e\xd0\x82\xef\x31\xa1\x1f\xa0\xa6\x32\x31\x31\x91\x4a\x27\x58\xa0\xb6\x51\xd0\x7c\x90\x14\x29\xec\x18\x9c\x6d\xba\xf3\x3a\x9e\xe2\x4c\x02\x93\x63\x33\xad\x00\x30\xb2\xd2\x2f\x1d\x4b\x58\x90\x44\xc9\xd6\x90\xe5\xd0\x50\x80\xd4\x9b\xb2\x3e\xf6\x3f\x8c\x88\x51\xd0\xa4\x2b\x3d\x30\xba\xdf\xfd\x11\x6f\xdd\x41\x63\x08\x7d\x2e\x69\x03\x2a\x42\xaa\xbd\x48\xf6\x44\x54\x50\x67\x63\xa3\xb3\x78\x2b\xbe\xb1\xda\xf9\xf3\xb7\xed\xb5\xcd\xf8\xfb\xfb\x1a\x49\x25\x33\x3f\x18\xf5\x41\xc7\x0d\x60\x10\x52\xc9\xc3\x01\xf3\xd3\xae\xb0\x9a\xe7\x0e\xa1\x33\xd5\xd2\x91\xd4\xa4\x49\x05\x20\x27\x84\x7a\x4a\x55\x4c\x12\x62\x9a\xa2\x24\x68\x48\x4d\x5a\x11\xb2\x64\x71\x9f\x8c\x9b\x24\x45\x93\x8c\xae\xc9\xed\x16\x72\x6d\x1d\xb3\xf9\x59\x1f\x7c\x13\x94\x11\x8f\x0a\xa1\xe3\xa0\x31\xeb\xf1\x46\xc3\x65\x0d\x8c\x38\x9b\x83\x96\xcd\x23\xa6\x63\xb7\x3e\x02\xf3\xc0\x64\x69\x0e\x5a\x53\xdc\x06\x5d\x1f\x3f\x36\x8a\x42\x90\xcd\x90\xa1\x3a\xf5\x04\xd4\x54\x38\x6d\x77\x3e\xd1\x1b\x05\x3d\x85\x51\x30\x78\x61\xc9\xbe\x25\xe9\xa3\x28\x40\x11\xb2\xbc\x1c\x91\xdd\xf8\x6c\xcf\x81\xe9\xe3\x44\xad\x4f\x5d\x36\xeb\x83\x10\xf4\x6c\x03\x72\xcc\xbe\x8b\xd9\x93\xce\x47\xd5\x68\xf0\xda\xa3\x2d\x08\x15\x2f\x97\xf5\x2f\x9d\x6a\xae\xbe\xee\x99\xea\x00\xfc\xc4\x48\x4a\x2a\xb1\xd2\xd7\xd5\xbd\xbd\xda\x59\x5c\x4f\x53\xab\x11\xf0\x93\x60\x56\x8d\x82\x56\xe7\xa1\x8f\x7c\x90\x0e\xb7\x75\x1c\x70\x21\x31\xa2\x4d\xc9\x2d\xee\x07\x1e\x48\xd0\x31\xaf\xd7\x71\x96\xd2\xc4\x81\x94\x49\xb2\x55\xb2\x99\x81\xdc\x15\xcb\x53\x61\x17\x4d\xd3\x77\xa5\x8e\x83\xc8\xb6\x3c\xa0\x21\xd2\x51\xa5\x46\xbf\xa2\x0b\xca\xc0\x48\x71\x8f\x70\xf1\x3f\xa8\x78\x80\xd3\xaf\x39\xe6\x0c\xc2\x90\x87\xc5\xd1\xda\xee\x0c\x22\x6a\xa9\x26\xd4\x71\x5a\x14\xa5\xc0\x09\xfe\xef\x73\x5b\xcf\x2a\xe2\x30\xf5\x83\x5a\x06\x49\x7c\x48\x0e\x92\x5d\xe6\x71\x0b\x44\xff\xcc\xa1\xc2\xd1\x7b\xe1\x1e\x48\xa7\x95\xa5\xdc\x65\x41\x53\x22\xd9\x0a\x40\xc7\x8e\x6b\xdb\xc0\xf2\x48\xa9\xc8\x28\x71\x8c\xe6\xa9\xd7\x04\x1d\xd3\x8a\x39\x6e\x55\xed\xac\xea\x87\x0b\x86\x50\x87\x10\xc2\x9e\xdc\x6b\x9b\xa2\x94\xb5\xc5\x80\x6b\x61\xdd\x57\xa5\x20\xa9\x99\xf4\xa3\x0c\xd5\x3f\xc7\x28\x68\x22\xa0\x2c\x07\x25\x8c\x67\x85\x67\x49\x85\x15\xe0\x81\x25\x33\xfb\xc9\x55\xdd\x05\xcf\xc6\x48\xc8\x96\x07\xaa\xba\x38\x95\x93\xc8\x83\xba\xac\x61\x14\xb9\xb6\x74\x74\x7c\xe4\xe8\x60\x1d\x28\x7c\x5e\x69\x05\x8d\x07\x2a\xc2\x39\x83\xa6\x80\x50\xe9\xce\x6b\x30\x5e\x5e\xd2\x48\x0a\xd9\x8f\x75\x6d\x6c\x9c\x3e\x31\x3d\x14\x00\x3e\x75\x3d\x6c\x1c\x57\xcb\x00\x88\xa4\x1c\x92\x5d\x40\x99\x5a\x13\xd2\xd2\x36\x76\xc5\x5a\x8d\xbf\x3c\xd2\x3d\xbf\x12\x1e\xf9\x57\x5e\xce\x07\xf4\x80\x70\xcf\x81\x8e\xab\xe5\xfe\x00\x58\x40\xc1\x6c\x3d\x04\x8c\x24\x35\x5d\x66\xc3\x82\x8e\x2b\xbd\xcc\xa9\xd4\x68\x44\xaa\x71\x45\x92\x34\x90\x3c\x29\xc4\x76\xe7\xdf\x2a\x6b\x9a\x47\x4d\xf0\x50\x9d\x87\x3a\x0e\xa8\x10\x11\x0f\xed\x6a\x1e\xe7\xf8\xd9\xd5\xee\x8f\x17\x27\x35\x92\x80\x8c\x21\x8c\x73\xb1\x34\x5b\x7b\x95\x64\xa1\xe8\x83\xde\x96\xe3\x7b\x53\x8b\xd7\xae\xc7\x57\x56\x3b\xb7\x96\x77\xee\xae\x4d\x0e\xf8\x9e\x15\x63\xaf\xb4\xce\x36\x41\xa8\x24\xbb\x79\x7d\xe5\x05\x38\x5e\xa9\xe6\xee\xf7\x31\x6a\xf6\x58\x0e\x65\x0d\x48\x26\xfe\xbf\x46\x8a\x07\x2a\x29\x8e\x96\x92\x24\x21\x03\x95\x47\xd1\xd7\x39\x8c\x32\x11\x41\x38\x1b\xf2\xa8\x07\x4b\x5a\xa0\x64\xbb\x22\xf0\x68\x4b\x2f\x16\x6b\xe7\x11\x78\x02\xde\x45\x86\x71\x06\xc5\xda\xf9\xfd\x8d\x51\xc6\xbb\xa2\x30\xd2\x59\x79\xb2\xbd\xf6\xaa\xbb\xb5\xb6\xfd\x6c\x3d\xfe\x6b\xab\x7b\xe7\x97\xd1\xa1\x7d\x50\xc1\x46\xe7\xd9\x52\x7b\xf3\x45\xf7\xde\xe5\x78\x79\x29\x7e\x7a\x77\x28\x74\x1c\x1b\xdd\xc5\x75\xf5\xf7\x46\x68\x55\x41\x5f\xb6\x37\x5f\xc4\xd7\x36\xbb\xb7\x1f\x28\xdd\x0f\x6e\xc6\x1b\x97\x86\x0a\x1c\xc1\x46\xe7\xd2\x6a\x7c\xe5\x65\x7b\xe3\x69\x7b\xe3\x42\x7b\x6b\x69\xfb\xc2\xc5\x78\x63\xf1\xcd\xa6\x8e\x26\x92\xed\x8d\x27\xed\xcd\xcd\xed\xb5\x87\xdd\xb5\xbb\x9d\x95\x4d\x25\xf6\xc3\x37\xf1\xad\xf5\xa1\x62\xff\x4d\xc4\x3a\x2b\x17\xe2\x6f\x57\x3a\xbf\xff\xd4\xbd\x77\x79\xe7\xe7\xe5\x9d\x5f\xaf\xc6\xcb\x4b\xdd\x47\xcf\x87\x8a\x1d\xc3\xc6\xce\xe3\x57\x3b\x8f\x5f\x76\xae\x3f\xea\x5e\x79\xa4\xe4\x97\x6f\xc6\xf7\xef\xb7\xb7\x96\x3a\x77\x9e\xef\xf6\x33\x9f\x25\xef\x52\xe0\xfd\x51\xb8\xb7\x1e\x7a\x53\x34\xab\x87\x49\x55\x0e\x07\x35\x44\xf7\xe9\xed\xce\xc3\xc5\xc9\x21\x6d\x3c\x38\xb8\x52\x13\xfb\x6d\xbe\xff\xe4\x7a\xa7\xae\x46\xbe\x19\x21\x33\x2a\xf7\xc8\xe4\x9d\x59\x29\x97\xff\x8d\x07\x98\x1d\x66\xa6\x08\x6a\x7d\x62\x85\xc1\xb1\x66\x71\x3e\xe7\x82\x74\x7b\x93\x77\x37\x6f\xcb\x01\x6b\xce\xe4\x0b\x7d\xcf\xad\x3c\x0c\x7d\xc9\x34\x14\xfb\x34\xed\xe6\x96\x55\xc2\xf8\xd1\x89\xf1\x72\xb9\x8c\x11\x22\xc6\xf6\xb5\x27\xf1\x8d\xd5\xfc\x4d\x94\x38\x65\x14\xfe\x99\x08\x98\x4d\x29\x79\xef\xf7\x38\x60\x28\x60\x16\xce\x38\xa7\x4f\x91\x9c\xe6\xc0\xeb\xa4\x47\x42\x86\xcd\x3d\xa9\x33\x34\x21\x43\xce\x1a\xd9\xe3\x5d\x23\xd9\xa7\x46\x52\x4b\x6f\xe0\x95\x2f\xea\xd9\xb2\xff\xf4\x75\x8b\x7a\x89\x1b\x05\x8d\x38\xd2\xf7\x8c\xc2\xdf\x03\x00\x84\xbc\x6f\xa2\x35\x0d\x00\x00")
+
+func dataLoginformHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataLoginformHtml,
+		"data/loginform.html",
+	)
+}
+
+func dataLoginformHtml() (*asset, error) {
+	bytes, err := dataLoginformHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/loginform.html", size: 3381, mode: os.FileMode(420), modTime: time.Unix(1792311873, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataNopermissionHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x52\xdf\x8b\x1b\x55\x14\x7e\x9f\xbf\xe2\xf4\xbe\x34\x79\xc8\xdc\xa6\x2b\xe8\x66\x67\x46\x68\xb2\xa0\x50\x6d\xd9\x46\x5a\x11\x09\x77\x66\xce\x64\xc6\x9d\x5f\xde\x7b\x92\xd9\x28\x42\xad\x85\x6e\x62\xa1\x3e\x14\x17\xad\x2b\x16\x14\x02\xe2\xae\x3e\x48\x63\x5b\xc8\x3f\x93\x4c\xb2\x4f\xfe\x0b\x32\x3b\x69\xcc\x8a\x20\xf8\x34\x67\xce\xf9\xbe\xef\x7c\xe7\x9e\x63\x5c\x6a\xdd\x68\xb6\xdf\xbf\xb9\x0b\x3e\x45\x21\xdc\x7c\xef\xda\xf5\xb7\x9b\xc0\x6a\x9c\xdf\xde\x6a\x72\xde\x6a\xb7\xe0\xce\x5b\xed\x77\xae\x43\x5d\xbf\x02\x6d\x29\x62\x15\x50\x90\xc4\x22\xe4\x7c\xf7\x5d\x06\xcc\x27\x4a\x1b\x9c\x67\x59\xa6\x67\x5b\x7a\x22\xbb\xbc\xbd\xc7\x0f\x0a\xad\x7a\x41\x5e\x85\x35\xda\x60\xea\x2e\xb9\xcc\xd2\x8c\xa2\x02\x07\x51\x18\x2b\xf3\x5f\x64\xea\xdb\xdb\xdb\x25\xfb\x1c\x8b\xc2\xb5\x34\x23\x42\x12\x50\x60\x6b\xf8\x71\x2f\xe8\x9b\xac\x99\xc4\x84\x31\xd5\xda\x83\x14\x19\x38\xe5\x9f\xc9\x08\x0f\x88\x17\xdc\x1d\x70\x7c\x21\x15\x92\xd9\x23\xaf\xf6\x06\x03\x6e\x69\x06\x05\x14\xa2\x95\x3f\xfa\x6a\xf1\xe3\xf3\xd9\xf4\x69\xfe\xf9\x29\xd4\x00\x6e\x91\xe8\x62\x5d\x51\x11\xab\xf3\x98\xab\x3a\xe4\x93\x49\x7e\xf8\x68\x3e\x1a\xe7\x2f\x7f\x5e\x9e\x3c\x9f\x1f\x3f\x31\x78\xc9\x5f\xb9\x89\x45\x84\x26\xeb\x62\x8c\x52\x50\x22\x37\x4c\xb4\x02\xe5\xf4\x3e\xb9\x04\x77\xb6\xf4\xd7\xca\xc6\xb6\x50\x08\xbe\x44\xaf\x1c\x58\x35\x38\xb7\x6d\xa5\x2b\x21\x85\x2d\xea\x8a\x74\x27\x89\xf8\x55\x9b\x97\x68\xbe\x1a\xda\x4e\xdc\x01\x04\xae\xc9\xe2\x7e\xc7\x4b\x64\x2f\x62\xe0\x84\x42\x29\x93\xa5\xdd\x4e\x3f\xc0\x8c\x7c\x89\xc2\x65\x90\xc4\xfb\x38\x70\x93\x2c\x36\x59\xe0\x55\xb0\x8f\x31\xe9\xfb\x38\x68\x26\x2e\x9a\xe6\xd5\xd7\xab\x20\x91\x7a\x32\x06\x4f\x84\x0a\x77\x8a\x67\x75\x83\xfe\xb9\x72\x96\xae\x35\xb3\x74\xb3\xe0\xd0\x46\x01\x9c\x10\xb2\x57\xd5\x55\x36\xf6\xc2\x7f\x64\xbc\x8e\x03\x22\xa4\x35\xb0\x90\x89\x50\x29\xd1\xc5\x62\x2d\x6b\x3d\x11\xa2\xa4\x0e\x4a\x99\xc8\x02\x9a\x5a\xf9\xe8\xb7\xfc\x97\xe1\x9f\x2f\x1f\xe6\xf7\xc6\xf9\xf0\xee\xfc\xbb\xf1\xe2\xdb\xfb\x8b\xc7\xe3\xfc\xf0\xd9\xe2\xc5\xfd\x4a\xfe\xf5\xaf\xf9\xf0\xcb\xd9\x64\xb4\x7c\x76\x5a\xcd\x8f\x7e\xc8\x8f\xbf\x58\x9e\x4c\xcf\x8e\x4e\x96\xa7\x3f\x2d\x86\x87\xf3\xe3\x23\x83\xa7\x96\x66\x28\x47\x06\x29\x01\x0d\x52\x5c\x1d\xc2\x47\xa2\x2f\xca\x2c\xb3\xb4\xc0\xab\xf8\x81\xa2\x44\x0e\xf4\x10\xe3\x2e\xf9\x60\x41\xe5\xda\xde\x8d\xdb\xb7\x76\xf7\xf4\x00\xe1\x4d\xb8\x02\x0d\xa8\x57\xab\xf0\xa9\xe6\x26\x4e\x2f\x2a\x5e\x31\x93\x01\x61\xe5\xb2\x91\x5e\x34\x6f\x53\x1c\xa2\x47\xcc\x32\xc4\x6a\xad\x7f\xb7\x6a\xbc\xea\x62\x0b\x67\xbf\x52\x65\xd6\x07\xb0\xb8\xf7\xc7\xfc\xc1\x8b\xe5\xf4\x9b\xb3\x07\x0f\x97\xd3\xc7\xf3\x27\xdf\xcf\x26\xa3\xd9\xe4\xee\xd9\xd3\xdf\xe1\x43\x83\x0b\xab\x18\xe0\x72\x75\x47\xfb\x0c\x30\x54\xf8\xbf\x0c\xe8\xbc\xe8\xb4\xbe\xe5\x8b\xb2\x9a\xc1\x4b\x73\x96\x66\x70\x37\xe8\xff\xd7\xa7\xb8\x3c\x4b\x33\xb8\x4f\x51\x68\x69\x7f\x0d\x00\x58\xc2\x0e\x4a\x2b\x04\x00\x00")
+
+func dataNopermissionHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataNopermissionHtml,
+		"data/nopermission.html",
+	)
+}
+
+func dataNopermissionHtml() (*asset, error) {
+	bytes, err := dataNopermissionHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/nopermission.html", size: 1067, mode: os.FileMode(420), modTime: time.Unix(1792311682, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _dataSingleHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x6b\x6f\x13\xcd\x15\xfe\x6c\x4b\xf9\x0f\x87\xb5\x4a\xbf\x64\xbd\x5e\xdb\xb9\x78\xbd\x5e\x89\x26\xa1\x8d\xc4\x4d\x10\x5a\xda\xaa\x8a\x66\x77\xc7\xf6\x92\xf5\xcc\x76\x77\x12\xc7\x44\x95\xb8\xb5\x21\x34\xa5\x42\x90\x92\x94\xa0\x10\x09\x5a\x40\x05\x57\x22\x0d\x7d\x43\x02\x7f\x26\x6b\x3b\x9f\xf2\x17\x5e\xcd\x8e\x6f\x21\x79\x23\xf6\x83\x2f\xb3\xe7\x3c\xf3\x9c\xe7\x9c\x39\x67\xf4\x33\xe3\x97\xc7\xa6\x7e\x7b\x65\x02\xca\xac\xe2\xc2\x95\xeb\xbf\xb8\x30\x39\x06\x92\xac\x28\xbf\xc9\x8c\x29\xca\xf8\xd4\x38\xdc\xf8\xd5\xd4\xc5\x0b\xa0\x26\x53\x30\xe5\x23\x12\x38\xcc\xa1\x04\xb9\x8a\x32\x71\x49\x02\xa9\xcc\x98\xa7\x29\x4a\xb5\x5a\x4d\x56\x33\x49\xea\x97\x94\xa9\xab\xca\x3c\xc7\x52\xb9\x73\xfb\xa7\xcc\xfa\x3c\x93\x36\xb3\x25\x63\x20\xae\x47\x3b\xce\x57\x5c\x12\x14\x4e\xc0\x51\x73\xb9\x9c\x70\x17\xc6\x18\xd9\xfc\xdb\x44\x01\x86\xb2\x8f\x8b\xc2\x27\xd0\x14\xc5\x34\x83\x64\x80\x7c\x64\x22\x35\x60\x49\x8b\x56\x94\xb4\xa9\x20\xdf\x2a\x3b\x73\xd8\x57\x24\x50\xb8\x1f\x73\x98\x8b\x8d\xf0\xcf\xf7\x9a\x5b\x2f\x1b\x1f\x57\xc2\xc5\x9d\xd6\xa7\x7a\xb8\xfe\x26\x7c\xf2\xee\x60\x71\xf9\x70\x77\x03\x64\x68\xde\x5d\x6a\x6e\x7c\xdc\xdf\x7d\xd9\xaa\x6f\x81\x0c\xd7\x18\x2a\x61\x35\x60\x20\x03\x5c\xa1\x55\xec\x63\x1b\xcc\x1a\x8c\x3b\x81\x35\x7b\xeb\x0c\x9c\x6b\xef\xa0\x2b\x02\x7b\x20\xae\xbb\x0e\x99\xf9\x1e\x72\xac\xec\x63\x64\xcb\x6a\x36\x97\xcd\x65\x87\x64\x55\x56\x93\x51\xa0\xe0\x63\xb7\x20\x59\x88\x50\xe2\x58\xc8\x6d\x53\xaf\x60\x86\x80\xa0\x0a\x2e\x48\x33\xb8\x56\xa5\xbe\x1d\x48\x60\x51\xc2\x30\x61\x05\xe9\x94\x90\x8e\xfb\xdb\x38\xb0\x7c\xc7\xe3\x89\xe8\x83\x80\xd3\x64\x19\xec\xc8\x70\x1c\xad\x84\x09\xf6\x11\xa3\x7e\x1f\x56\x47\x9d\x1b\x99\x64\xf6\xb8\x07\x9a\x65\xe5\x13\xcd\xa7\x30\xaa\x00\x22\x36\x8c\xd1\x4a\x80\xc9\x2d\xb8\x3e\x19\xad\x1d\x87\xb0\xa8\x57\xf3\x9d\x52\x99\xf5\xa1\xa4\x53\x29\x55\x4e\xa7\xd4\x91\xae\xfb\x24\xb1\x92\x6d\xdf\x80\xd5\x5c\x0c\xac\xe6\xe1\x82\xc4\xf0\x3c\x53\xac\x20\xe0\x25\x15\x33\xa9\x5d\x83\x85\x22\x25\x4c\x2e\xa2\x8a\xe3\xd6\x34\xf8\x35\xf6\x6d\x44\x50\xfe\xfc\xe5\x4b\x53\xf2\xb5\xc9\xdf\x4d\x68\xa0\xa6\xbd\xf9\xfc\xc5\x73\x57\x7f\x39\x79\x49\x83\x54\xde\xa2\x2e\xf5\x35\x48\xa4\xa2\x27\x6f\x22\x6b\xa6\xe4\xd3\x59\x62\x6b\x90\x28\x46\x4f\xfe\x4f\x03\xf1\x98\x53\x29\xc1\x82\x49\x7d\x1b\xfb\x5a\x2a\x5a\x71\x1d\x58\xa8\x20\xbf\xe4\x10\x99\x51\x4f\x83\x51\x6f\x3e\x5a\x4f\x7a\xa8\x84\x61\xc1\x43\xb6\xed\x90\x92\x06\x59\x6f\x3e\x0f\xc2\x53\x18\xaa\xde\x3c\x24\x26\xa2\x07\x02\xea\x3a\x76\xe4\x25\x94\x84\x85\x1e\x01\x59\x50\x8b\x4c\xcf\x9f\xcf\x43\x17\x71\xf8\x44\x44\xdb\xb6\x6d\x8c\x7b\x88\x09\x82\xe6\x06\x21\xd1\xd6\x74\x10\x12\x98\xd8\x7d\xb4\x46\x7b\x20\x02\x20\x72\xec\x10\xcb\x83\xe5\x62\xe4\x6b\x60\x52\x56\xce\x43\xd5\xb1\x59\x59\x83\xdc\xd0\xcf\xf2\x20\x62\xd6\x00\xcd\x32\xda\xf9\xd7\xa6\x91\x6a\x4b\x90\xe0\x87\x1b\xfb\x83\x90\x28\x52\xca\xb0\x0f\x0b\x47\xec\xd2\xdc\x0e\x22\x43\x97\x96\x1c\x52\xa4\x7e\x05\x16\x78\x2a\x65\xe4\x3a\x25\xa2\x81\x85\x09\xc3\x3e\xc7\xd2\x95\x28\xdd\x3c\xef\x4a\xb7\x65\xf0\x3c\xcf\xf1\xb3\x59\x90\x12\x99\xe8\x91\xe0\xe8\x5f\x6e\x26\x40\xc0\xb1\x0b\x92\xe0\x13\xad\xda\xce\x9c\xc1\x3f\x20\xc2\x2d\x48\xb6\x13\x78\x2e\xaa\x69\xe0\x10\xd7\x21\x58\x36\x5d\x6a\xcd\xe4\x25\x43\x17\x07\xab\xbf\xce\x6e\xa2\x39\x24\x56\x25\x23\x0e\x00\x50\xa2\xb4\xe4\xe2\x69\x64\x4f\x5b\xae\x83\x09\x83\x02\x48\x16\x92\xbd\x59\x53\xce\xa6\x46\x33\xd9\x54\x36\x9b\x4a\x8d\x8c\x0c\x8f\x0c\x8f\x4a\xf9\x6f\x3c\x02\x97\x46\xf6\xa3\xc3\xb9\xcc\xd0\xd0\xf0\x68\x3a\x75\xcc\x24\x52\x1d\x0a\x30\x92\x1e\xfd\xf6\x55\x19\xf3\x13\x03\x05\xc8\xa5\xf2\x5c\xa3\x88\x95\x11\xd7\xcf\xc8\x32\xb4\xfe\xfb\xa9\xf1\x7e\x39\x7c\xb5\xd5\x78\xb1\x19\xfe\xf0\x35\x7c\xfc\x10\x64\xd9\x88\x9f\x1e\x50\x3c\xf0\xad\x82\xa4\x28\xbc\x78\x91\x9d\x4e\x8a\xad\x82\x1a\xb1\x1d\x0b\xf1\xf6\x12\xb5\x3a\xf1\x56\x09\xca\xb4\x3a\x8d\xec\x20\x79\x33\x90\x8c\xde\xf6\xba\x12\x89\x2b\x3e\xcb\x69\xa3\xd3\x68\x7e\x1e\x74\x7b\x2b\xe8\x4a\x39\x1d\x25\x53\x64\x47\xa4\x82\xa7\x88\xa0\xb9\xe8\x10\xeb\xa8\xdd\x70\x93\x8a\x64\xb4\x3e\xec\x84\x2f\x9e\xeb\x0a\x32\xe0\xac\x1f\xa0\x3f\xce\xd2\x3c\x74\x0d\x8a\x8e\x2d\xab\x99\xb4\xe8\xb4\x46\x7f\xaf\x3f\xea\x70\x4a\x33\x14\x5c\x07\xe2\xa2\x2e\x22\x1e\xed\xf3\x12\x71\x89\xc5\x74\x0f\x2c\x17\x05\x41\xb7\xd3\x89\xe5\x58\x4c\x0f\x98\x4f\x49\xc9\x08\xd7\x9e\x85\x4f\xde\x86\x6b\xcf\x78\xa1\x46\x2b\xc2\x20\xfc\xfb\xe3\xd6\xe6\x9b\xfd\x9d\x47\xc0\xdb\x98\x9c\x95\x47\x40\xcd\x6a\xea\x70\x4c\x57\xbc\x36\x74\x39\x73\xda\xf8\xd2\x95\x72\x26\x32\xdc\xff\xfc\x39\x7c\xb8\xd9\x5a\xdb\x6b\xde\xdf\x0e\x5f\xef\x35\x1f\xbd\xdd\xdf\xfb\x4b\x63\xb5\x1e\xee\xde\x0e\x17\x77\x1a\x5b\x5f\x9a\x7b\xcf\x1a\xff\xa8\xb7\xbe\xae\x36\x56\xeb\x8d\x7b\x1f\x5b\xf5\x95\x56\x7d\xe5\x70\x77\xb9\x79\x7f\xbb\x75\x7b\xb9\xb1\xbe\x13\x3e\xd8\x6e\xd4\x9f\x1e\x7c\x59\x6b\x2e\xfd\x3b\x7c\xf5\xb7\xc6\x8b\xbb\x8d\xa7\xdb\xcd\xe7\x0f\xbb\x8b\xfb\x7b\x8f\x0e\x77\x97\x0f\xfe\xf9\xe5\xe0\xce\x93\xd6\xd7\xd5\xd6\xbf\xee\x34\xdf\x2f\x85\x8b\x2f\xf7\xff\xff\xae\xb9\xf4\xa0\xb1\xfe\x1f\x73\xb6\x58\x3c\xdc\xdd\xe0\xac\xb9\x4e\x6d\x49\x78\x35\x08\x41\x0e\x36\xff\xa7\x41\xbc\xa3\xc9\xef\xd5\x3f\x74\xd5\x80\x78\xac\x23\xf2\x09\x62\x63\x12\xdd\x1c\x62\x8d\x8d\xd7\xcd\xf5\xbf\x86\x1f\x96\x1b\x2b\x5b\x62\x47\xed\x48\x29\x24\x7f\x7a\xc2\x32\xe4\x97\x30\x2b\x48\xd3\xa6\x8b\xc8\x0c\x3f\xb7\xed\xc4\x9c\x26\x6d\xdb\x86\xd7\x49\x8f\x97\x6e\xfa\x62\xc0\xb4\x4b\x93\x07\xd6\x65\x2a\x1a\x99\x88\xb6\xef\xee\xd0\xd9\x4d\x47\xdf\x12\xe9\xbb\x36\xb4\xef\x41\x76\x34\x1a\x93\x04\x33\xc9\xe8\x9f\xaa\x7d\x17\x0f\x64\xf4\x84\x3b\x4b\xcc\xc0\xcb\xc3\x59\x3e\x1f\xa1\x37\x10\xbf\x67\x27\x4b\x0c\x4d\xfe\x2d\x19\xfd\x13\x54\x04\x1c\x8b\x75\x42\xed\xfb\xd5\xcb\x52\x37\x7c\x5d\xe1\x9d\x56\xb4\x5e\x56\x71\x8d\x81\xf8\x8f\x01\x00\x00\xff\xff\x1c\x32\x28\x17\x5e\x0a\x00\x00")
 
 func dataSingleHtmlBytes() ([]byte, error) {
@@ -152,6 +343,26 @@ func dataSingleHtml() (*asset, error) {
 	return a, nil
 }
 
+var _dataThreadDeletedHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x5a\x6d\x53\x1b\x47\x12\xfe\x6c\x7e\xc5\x44\xae\xcb\x7d\x89\xa4\xdd\xd5\xbb\x00\xe5\x72\x21\xb9\x73\x55\x9c\x50\xb1\xef\x2e\xb9\xab\xab\xd4\x4a\x5a\x83\x62\x49\xab\x68\x17\x63\xe2\xba\x2a\x61\x2c\x90\x00\x21\x81\x31\x06\x83\xcd\x8b\x0d\xf8\x6c\x03\xc2\xc6\x46\x48\x18\xfe\x4b\xa2\x59\xed\x7e\xca\x5f\xb8\x9e\x1d\x49\x08\x90\xac\xf5\x9d\xeb\xb6\xb6\xf4\x32\xdb\xd3\xf3\x74\x4f\x77\x4f\xf7\xce\x74\x7d\xd4\xf3\xcd\xe7\x57\xbf\xef\xfd\x02\xf5\xcb\x91\x30\xea\xfd\xcb\x1f\xbf\xba\xf4\x39\x32\x99\xad\xd6\xbf\xd9\x3e\xb7\x5a\x7b\xae\xf6\xa0\xef\xfe\x7c\xf5\xf2\x57\x88\xb5\x30\xe8\x6a\x9c\x8f\x4a\x21\x39\x24\x46\xf9\xb0\xd5\xfa\xc5\xd7\x26\x64\xea\x97\xe5\x98\xd7\x6a\x1d\x1c\x1c\xb4\x0c\xda\x2c\x62\xbc\xcf\x7a\xf5\x5b\xeb\x4d\xc2\x8b\x25\x9d\xab\x3f\xcd\x72\x43\x4f\x4b\x50\x0e\x9a\x7c\x1d\x5d\xfa\x80\x37\x23\xe1\xa8\xd4\xdd\x84\x0d\xeb\xf1\x78\x68\x6f\x9d\x56\xe0\x83\xf0\xe5\xe7\x25\x01\xf5\xc7\x85\x6b\xb4\x87\x04\x5d\xfc\x7e\xc9\x22\xf1\x71\xde\xcf\xb3\x92\x6c\x09\x88\x11\x2b\xe7\xb7\xf2\xf1\x40\x7f\xe8\x86\x10\xb7\x9a\x90\x15\xba\xc9\x21\x39\x2c\xf8\xae\xb0\x4a\xa1\xa0\xa4\xb2\x78\xb2\x58\x2e\x4e\x2a\xe9\x09\xfa\xb7\x5c\x7c\xa2\xcc\xdf\x55\xf7\xf6\x90\x19\xd1\x16\x75\xbb\x88\x1f\x2e\xc2\xdf\x2b\x32\xdf\x27\x00\x5b\xf8\x89\x7a\xc5\x41\x21\x2e\x04\x91\x7f\x08\xf5\x84\xa4\xc0\xc0\xcf\x1f\xa1\xcf\xaa\xa3\x74\x59\xe9\x00\x1d\x5d\xe1\x50\xf4\xba\x11\x7c\x32\xd0\xf0\x41\x33\x6b\x63\x6d\x0e\x87\xdd\xcc\x9a\x59\x8b\x2e\x29\x8a\x0b\xe1\x6e\x53\x80\x8f\x8a\xd1\x50\x80\x0f\x53\xf4\x11\x41\xe6\x51\x94\x8f\x08\xdd\xa6\xeb\xc2\xd0\xa0\x18\x0f\x4a\x26\x14\x10\xa3\xb2\x10\x95\xbb\x4d\xed\xa4\x3a\xc7\x23\x28\x48\x81\x78\x28\x46\xe6\xa2\x81\x0d\x6a\xab\x9d\x4f\x6a\xda\x38\xc7\xb1\x4f\x88\x0a\x71\x5e\x16\xe3\x0d\xfc\x6a\x3a\xfa\xce\x66\xb1\x9f\xeb\xc0\x0f\xc8\xfd\x4d\xa9\xaf\x0a\x7c\x04\xf1\xd1\x20\xfa\x5c\x8c\x48\x42\xf4\x67\xf4\x97\x4b\x7a\xdb\x39\x0e\x01\x31\x36\x14\x0f\xf5\xf5\xcb\x0d\x4c\x38\x86\x61\xcd\x1c\xc3\xba\xea\xbd\x2f\x45\x03\x16\xda\x55\x92\x87\xc2\x02\x92\x87\x62\xd0\x57\x16\x6e\xca\xd6\x80\x24\x81\x5d\x5d\xf0\x8b\xc1\x21\x74\xeb\x1a\xb0\x30\x5f\xe3\x23\xa1\xf0\x90\x17\xfd\x55\x88\x07\xf9\x28\xdf\xf9\xe5\x37\x5f\x5f\x35\x5f\xb9\xf4\xf7\x2f\xbc\x88\xe5\x62\x37\x3b\x2f\x7f\xf6\xed\x9f\x2e\x7d\xed\x45\x4c\x67\x40\x0c\x8b\x71\x2f\xba\xc8\xe8\x57\xa7\x9f\x0f\x5c\xef\x8b\x8b\x03\xd1\x20\xb4\x5d\xd3\xaf\xce\x7f\x75\x5c\x08\x45\xfa\xd0\x2d\x3f\xcc\x96\x10\xf7\x32\xa4\x21\x1c\x42\xb7\x22\x7c\xbc\x2f\x14\x35\xcb\x62\xcc\x8b\xdc\xc0\x14\x9a\x2d\x31\x50\x2b\xba\x15\xe3\x83\xc1\x50\xb4\xcf\x8b\xec\xd0\x8c\x68\x3f\x4a\xc7\xc6\x6e\xa2\x8b\x5f\xe8\x17\x92\xc4\x70\x28\x48\x3a\x51\x15\xc2\x00\xf5\xc1\xcd\x14\x96\x4e\xf9\xe5\x97\x9d\xa8\xce\xd0\xd9\x94\x61\x10\x2e\x41\xa8\x33\xbc\x18\xe5\x6f\x7c\x82\x2e\x56\x95\x09\xbf\x04\x98\x85\x13\x50\xee\x13\x1e\xb4\xbf\xde\xaf\x06\xab\x13\x05\xc2\x02\x0f\x4f\xfc\xa2\xdc\xdf\x89\x06\x43\x41\xb9\xdf\x8b\x3c\x8e\xdf\x75\x22\x2a\xb0\x17\x01\x5e\xb1\xf6\xaf\x8a\x82\xa1\xf2\x5f\x24\xbe\x2d\xc4\x61\xc8\x6b\xa2\x28\x0b\x20\xd3\x29\x32\x8e\x90\x21\x42\x17\x16\xa1\xf1\x9a\x18\x8f\xa0\x5b\x64\x0a\xcd\x7c\x38\xd4\x07\x9c\x03\x80\x57\x88\x03\xa7\x2e\xab\x3e\xcb\x30\xdb\xd6\x5a\xb8\x20\xb3\x7b\x83\x38\x65\xb7\xe9\xa2\x4d\xbf\x4c\xe8\xf4\x5f\xa0\xa2\x0c\x50\x28\x08\x4e\xab\x43\x21\x8d\xc1\xd0\x0d\x1f\xf9\x40\x3a\x4f\xf0\x9a\x90\x14\x0b\xf3\x60\x1e\xa1\x28\x30\x10\xcc\xfe\xb0\x18\xb8\xde\x69\xf2\x75\x51\x67\x6a\x34\xad\x1f\xf9\x1b\x3c\x6d\x05\x46\x08\xae\x3e\x51\xec\x0b\x0b\x3f\xf0\xc1\x1f\x02\xe1\x10\x0c\x86\xba\x11\xf8\xb8\x39\x36\xe0\x37\xdb\x19\xb7\xcd\xce\xd8\xed\x0c\xe3\x72\x39\x5d\x4e\xb7\xa9\xf3\x4c\x0f\x29\x2c\xea\xf4\x6e\xa7\x07\x42\x85\xd3\xcd\x31\xe7\x48\x74\x75\x03\x8d\x8b\x73\x9f\x7d\xd4\x2f\x10\x1f\x81\x67\x1e\xa6\x93\xe8\x47\x47\x05\xd2\x7d\x64\x36\x23\x35\xbf\xaf\x6c\x4d\xe2\x27\x7b\xca\xc3\x55\x7c\x70\x8c\xa7\xc7\x91\xd9\x4c\x5c\xe5\x5d\x02\x75\x48\xf1\x40\xb7\xc9\x6a\x25\x36\xcb\x07\x39\x0b\x1d\x4a\x1a\x8a\x06\x21\x5e\x91\x90\xa2\x47\x38\xfa\xd4\x2a\xf5\x8b\x83\x80\x42\xb2\xfc\x48\x7c\xad\x3e\x7c\x97\x55\x57\x2e\xfd\xec\xe7\x7c\xb5\xb8\xf2\x7b\xa9\x1e\x51\x11\x4c\x21\x47\xba\xd0\xb9\xa1\x33\x41\x26\x08\xac\x94\xb8\x6d\x17\x5f\x8d\xb2\x16\xab\xc9\x47\xc3\x75\x97\x95\xf7\xa1\x8f\xe3\x12\xff\xd3\x00\x18\x5a\x9d\xe0\x5a\x28\x68\xb6\xd3\xe0\xea\x6b\x8c\xee\xa7\xc9\xdb\x05\x3f\x0a\xb6\xa3\xa3\x8e\xa3\xea\x27\x04\xcb\x85\x0b\x5d\x31\xb0\x7f\x5e\x92\xea\x81\x4d\x6f\x85\x76\x49\x8e\x8b\xd1\x3e\xdf\x65\x21\x2c\x87\xa2\x3c\xb1\x4f\xfd\xbf\xfe\x14\x67\xa7\xd5\xd5\xa7\xe5\xe2\x14\x58\x38\xeb\x34\xbb\xcc\x1c\x8b\x38\xd6\xcb\xda\x2f\x74\x59\x63\x94\x6d\xbf\xcd\xd7\x1e\x18\x10\x01\x31\x52\x96\x5e\xe0\xc2\x9c\xb2\x94\xc0\xb9\xa9\xca\xec\x2e\xaa\x8e\x89\x1a\x07\xe0\x10\xcb\x7a\x1d\x0e\x54\x39\x9c\x53\x8f\xa6\x51\x97\x3f\xae\xc7\x46\xfa\x55\x3e\x98\xc0\xe9\x4c\xe5\xc1\x1d\x60\x83\xb7\x72\x38\x9b\x52\x5e\xae\x96\x8b\xa3\xbf\x1d\x4e\xe2\xd1\x0c\x3e\x4c\x94\x0b\x70\x3f\x53\xe6\xf2\x40\x73\xb6\x8f\x76\xfb\x31\x3c\xfb\x25\xb1\x01\x77\xe5\x55\xa9\x52\x5a\x56\xb2\xb9\xca\x93\xa2\xba\xf6\x9c\x76\xc3\xd9\xe7\x78\x7f\x4f\x2b\x96\x2a\x47\x53\x40\x5f\x49\xa7\xca\x85\x92\xba\xb3\xa7\xa4\xa6\x95\xf4\x11\xce\x67\xf1\x66\x4e\x9d\x7a\x8e\x73\x19\x9c\x7c\x83\x37\x16\x70\x6a\x45\x1f\x7b\x99\xfc\xdd\x7e\x04\x64\xe5\x42\xa6\xb2\xbc\xae\x0d\xdf\x2d\x17\x8a\xe5\x52\xa2\x7c\x90\x02\x64\xe5\x83\xe5\x93\xf6\xd2\x14\xbe\xfb\x4c\x1b\x9b\x84\xbe\x30\x04\x05\xa3\xa4\x13\xe5\xd2\x3a\xc5\xa9\x1e\xcf\x2b\x4b\xe9\x72\x71\x11\x04\xc3\xdb\x93\x4a\x2a\x47\xd5\x58\x97\x19\x18\x92\x81\x0e\x96\x09\xc1\xf8\x23\xe5\xd5\x3d\x22\xfc\x93\x4d\xbc\xfd\x06\x3a\xaa\xdb\x79\x7c\x74\x1f\x88\xd5\xb1\x67\x78\x7f\x97\x28\x25\x0b\xa8\x12\xda\x70\x06\xe7\x36\x4f\x2b\xf3\xf4\x17\xd0\xc1\x00\xea\xe6\x1d\x9c\x5a\xf8\xed\xf0\x41\xb5\x15\x06\xa9\x35\x4d\x56\x52\xbb\x78\x66\x82\xdc\x4b\xf9\x33\x7d\x93\x19\x75\xef\x31\x7e\x9c\x57\xe6\xa7\x4e\xfa\x52\x1b\xc6\xd9\x67\xa0\xe6\xf2\xd1\x22\x91\xe3\xf5\x30\x3e\x78\x85\xb3\x79\xc2\xad\xb8\x52\x29\xee\xab\x1b\xc3\x14\xa9\xba\xf9\x18\x8f\xbe\x22\xda\xa2\x56\xb3\xb3\x4f\xf4\x7d\x3c\x82\x77\x0e\xd4\xdd\x55\x25\x39\xa5\xcd\xbf\xd4\x9e\xdd\xa7\x0a\x28\x97\xe6\xb5\x99\x5d\x2a\xab\xfa\x3a\xaf\xcc\xee\x40\x0b\xde\x7e\x08\x2a\xac\xec\x96\x9a\x8a\x77\xc1\x80\x0b\x44\x20\x6c\x83\xbf\xb4\x73\x01\xdd\x42\xed\xcc\x29\x17\xa8\x99\x38\x1e\x2b\xf6\x5e\xb1\xe3\xe4\x53\xbc\x36\x82\xf3\xc3\xda\xec\x30\x1e\x5f\x51\x8f\x72\x38\x7f\xc7\x4e\xac\x2a\xad\x1b\x44\x21\x83\x27\x93\xda\xed\x6d\x5d\x94\x7d\x65\xe5\x10\xc4\xad\x6c\x0e\xab\xc3\xe3\x46\x60\x0a\xe1\x9b\x43\x06\x30\xda\xbc\x76\x4f\x53\x8c\xe7\xdc\x90\x30\x3c\xf1\x41\xb7\xd9\x4d\x04\xb4\xb9\x9b\xbb\x20\x95\x02\xd9\x7a\xae\x54\x13\xd1\x6a\xfb\xc7\x51\xbf\x14\xeb\x44\xd5\x2f\xed\xdf\x2f\x88\x91\xaf\xaf\xab\xaf\x17\xd5\xb7\x1b\xc8\x05\xa2\x7a\x18\x84\x93\x23\x4d\xc9\x95\xf9\x65\x62\xfe\x99\x55\x65\x67\x16\x95\x0b\x69\x6d\x2c\xa3\xee\x3e\xc3\x19\xb0\xa6\x49\xd6\xf6\x8e\x7e\x73\x79\xf5\x4d\xb2\x52\xdc\xac\x8f\x87\x93\x77\xf0\xe1\x32\xe2\xda\x8c\xb7\x94\xa8\x94\x52\xf8\xa0\xa4\x8c\xbc\x42\xea\xf8\x6d\xbc\x9e\xc5\xe9\x4d\xbc\x54\xfc\xed\x30\xa5\x3d\x7c\xa4\xe6\xd7\xf0\x01\xf8\x55\x1a\xb9\x1b\x79\x54\x45\xff\xfa\xdd\xa2\xe3\x89\x31\x35\x91\x54\xe6\xee\x2b\xc3\x6f\xb4\xa3\x05\xe4\x39\x0b\x85\xf8\x7a\x61\xbc\xb2\xbd\x0b\xa1\x05\x2c\xa5\xd1\x20\x7e\x4d\xdc\xc6\x93\xf7\xcb\xc5\x17\x38\xbb\xa3\x3d\x5c\x03\x5f\x80\x16\x23\x36\x11\xfc\xf1\xa7\x1f\x18\x96\x69\x67\x16\x36\xc4\xda\xbd\x8c\xdb\x98\x59\x54\x79\x36\x5a\x86\x0b\xb1\x0e\x2f\x67\x6f\x6e\x19\x78\xff\x25\x98\xbf\x11\xb4\x3d\x5f\x5d\xe9\xf9\xf2\xfb\x76\x60\xed\x88\x75\x7a\x39\x87\x31\xb0\x94\x65\x23\x56\xd6\x4e\xcc\x98\x63\x5a\x98\xb1\xde\x68\x04\xec\x75\xa8\x8b\x20\x6b\x6f\xab\x5b\x07\x62\x3c\xa0\x9b\xa6\x70\xab\xe8\x5a\xca\x58\xd3\xe0\x58\x11\x62\x35\xc4\xc7\xa8\x30\x68\x0b\x4a\xe1\x30\xf2\x80\x85\x83\xac\xcf\x2a\x2b\x7b\x10\x21\x11\x2e\x6c\x28\xf7\xd7\x89\xec\x53\xcb\xea\xe3\x24\x4e\x26\x2b\xb3\xaf\xf1\xc2\x53\xa4\xe4\xf7\x94\x85\x37\xf8\xf1\x2b\x54\x59\x7d\x01\xb6\x44\x17\x9f\x93\x45\x90\x75\xe0\x83\xbd\xf2\x01\x59\x16\x11\x5e\x2e\x12\x0e\x4b\x4f\x71\x6e\xa4\x32\x5c\x0b\xe5\xda\x6a\xb1\x7c\x74\x0c\x08\x50\xa4\xdf\xd6\x07\x1f\x76\xf2\x81\x2c\x16\x4b\x6d\x29\x58\x2a\xc2\x48\xda\xda\x92\x9a\x7e\x09\x6b\x1e\x0d\x5c\xf0\x43\x1d\x79\x8b\x33\xf7\x00\x59\xa4\xff\x26\x18\xf2\x7b\xc4\x5b\x79\x40\x8c\x0b\x83\xed\xf5\xca\x7a\xbc\x36\xae\xa9\x5e\xa9\x47\xe2\xd4\x43\xbc\xf8\x28\xd2\x27\xdd\x50\x32\x6b\x64\xf9\x2f\xec\x40\x6b\x4c\xb2\x53\x2f\x45\x50\x82\x80\xd6\xa0\x09\x74\x4a\x9b\x0c\xad\x06\xa1\xfe\x81\x21\xa1\x1d\x3a\x27\x62\x5c\x5e\xc6\x6e\xcc\x48\x29\xcb\x46\x23\x25\x06\xc0\x79\xed\xec\xbb\x3c\x0a\x42\x00\xbd\x8d\xa0\xc6\x0f\x6f\x93\x51\x87\x21\xc4\x24\xda\x63\x27\xd1\x80\x6d\x8a\xbd\x0a\xb5\xa5\xc0\x35\x84\xe9\x8c\x32\x35\x83\x33\x2b\xe5\xb7\x19\x7c\x94\xa4\x52\x90\x14\x65\x29\xa1\x1e\x4f\x43\x42\x82\xc7\x56\x60\x46\x7a\xaf\xfc\x95\x2a\xbe\xb2\x95\x56\xd2\x77\xb5\xc4\x03\x90\x4b\x99\x4a\x9f\x16\x57\xd9\xbd\xad\xee\x6c\x54\x4a\xa3\x86\x22\x5e\x0c\xea\x86\x9b\xac\x01\x19\x5d\x67\xf3\xd5\x9a\x8c\xb5\x00\xd7\x32\x4e\x9e\xb8\x25\x2c\xea\x5a\x69\x5a\xdb\xba\x6d\x03\xe3\x2a\x17\xb6\x48\xce\x55\xd8\xa0\x86\x57\x49\x1f\xe0\x64\x01\xcf\x4c\x2a\xe3\x07\xb8\x30\x02\x8f\x20\x5c\x40\xb8\x6f\x0c\xec\x67\xd2\xd8\x5a\x3a\x88\x33\x73\xa0\x05\x48\x22\xf5\x4c\x71\x19\x14\xd0\x7b\x99\x24\xa2\x33\x05\x6d\xf7\x50\xbb\x7b\xa4\x4c\xad\x7f\xc8\x10\xe5\x22\x59\x01\xeb\x6a\xaa\x8c\x2a\x34\xe7\x45\x92\x69\xe6\x26\xf1\xd6\x86\x92\x98\x02\x58\x24\xcd\xcc\x66\x70\x71\xb6\x4a\xf0\x87\x6a\x2c\xfb\x70\x71\x5e\x47\x65\xe7\xfe\xbb\x38\x0f\xfd\xdd\x24\xf0\x32\x9e\xff\x57\x9c\x77\x91\x90\xcd\xd8\xde\x2f\xce\x57\x65\x3c\xc9\xb5\x61\xce\x39\xe5\xc5\x6a\xe5\xf9\x02\x1e\x4d\x96\x8f\x57\x89\xcf\xbc\x5c\xad\x2c\x4d\xe0\x54\x1e\xe7\xee\x83\x31\x9c\x91\x63\x79\x4b\x79\x93\x52\x86\x77\xa0\x28\x80\xa4\x01\x68\x21\xab\xd6\x46\x67\x80\x4f\xbd\x1f\x2d\x0d\xc0\x22\xf1\x93\x4c\xf9\x2d\xa9\x2f\xc0\x9a\x8a\xa3\x1f\x36\x85\x00\x49\xdc\xad\x32\xcb\xaa\x4f\xa2\x96\x6e\xf8\x5f\xd8\xff\x99\x88\xb8\xf8\x08\x24\xd3\xc5\x22\xee\x05\x42\x2e\x4d\xc0\x6d\x28\x32\x26\x53\x30\xa8\x72\x7f\x05\x3e\xdb\x09\xa9\x67\xc0\x8c\xb3\x55\x8a\x4f\x57\x13\x48\x5a\x95\xd7\xfb\xb0\x3c\xd9\x1d\x8d\x19\x3d\xaa\x67\x76\x46\x50\x85\x45\x29\x62\x00\x8d\xb3\x55\xc1\x61\x30\x4e\xd7\x03\x30\x94\x67\xea\xdb\xa7\xea\xf1\x01\x5d\x5b\x6a\xd6\xb5\xb7\xa6\x66\x5f\x12\xcf\xdf\x5f\x2f\xbf\x5d\xc2\xdb\x4f\x10\x8d\x73\x50\xed\xd7\x93\x93\xed\x47\x78\xf4\x35\x1e\x5f\xd4\xc6\xb2\xca\xe3\x99\x4a\x6e\x84\x0c\xc5\xb9\x98\xa6\xf5\xd6\x79\x8e\x24\xb9\x29\x8e\x52\xdb\xfe\x70\xeb\xaf\x9b\x98\x23\xd3\x3c\x78\x10\xdd\xa2\x96\xea\x34\x04\xf4\xb4\x50\xa4\x38\x87\x70\x38\xbd\x66\xd0\xa5\x0c\x4e\xae\xdb\xcb\xb9\xde\x6f\x72\xab\x52\x37\x81\x75\x6e\x45\x8d\x45\xde\x77\x35\x51\x16\xee\xe2\x9d\x19\x03\xb8\x21\xea\x36\xcf\xce\x61\xb5\xb4\x2a\xa9\xf9\xca\xfa\x23\x9b\x95\xda\x91\xb5\xee\x14\x56\xf0\xc0\x72\xa1\xa4\xad\xcd\xd3\x75\xd3\x5a\x59\x9c\x81\xaa\x5e\x49\xe5\x20\xaf\x24\xd9\xc2\xc1\x14\xf1\xd1\xfb\xf7\x6a\x99\xab\x8b\x39\xb5\x84\xea\xa9\x6b\x9e\x16\x47\xac\x93\xf9\x90\x96\xc4\x41\xd5\x6b\x7b\x4f\x4b\x22\x73\xd7\x4a\xd9\xe7\x92\xb9\xde\xcb\x86\xf4\xff\x30\x8f\x93\x13\x38\xfb\x12\xef\x8c\x2a\x73\x3b\xef\xc4\x0d\x19\xa4\x5e\xe5\x18\x5c\x3d\xcf\xb0\x46\xa7\xf9\x90\x4a\xa4\xf9\x2a\x4a\x32\xb3\xbd\xad\xde\xcb\x90\x00\x69\x77\xdf\x40\x90\x53\x37\x86\x21\xff\x51\xb7\x57\x95\xad\x75\xa8\x0a\xd4\x04\xa4\x3a\xb3\xf5\x39\x06\x6f\xd2\x73\xc0\xb4\x72\x27\x8b\x73\xd3\xfa\xbb\xab\x0d\x5a\x30\xf4\x5e\x26\x4b\x54\x6e\xd3\x88\x22\xe2\x42\x50\x0c\xc8\xa2\x9f\x6c\x5e\xb5\xd1\x01\x4c\x5d\x8b\x0c\x42\x99\x7d\x43\xc3\x75\xb9\x30\x57\xb9\x37\xa9\xac\x4c\xab\xc7\xfb\x78\xfb\xb9\x1d\x92\x37\x52\xcd\xfb\x05\x49\xd6\x9f\x66\x40\x26\x44\xaa\xa0\x42\x1e\xd6\xd2\x72\x69\xfe\x43\x2d\x9a\xb5\xb2\xb9\xf9\x9a\xa9\xee\xdc\x03\x88\xe0\x31\xd4\x51\xb4\xad\x59\x82\x32\x35\xaf\x6e\xe7\x1d\x86\xac\x65\x75\x44\x1d\xdf\xc5\x2f\x17\xc1\xa9\xda\xc0\x00\x73\x65\xbc\x9c\xcd\xa0\xa9\x34\xf0\x3d\xb1\x13\x96\xa1\xca\x66\x6c\x2d\x2a\x96\xb1\x22\x78\x7d\x65\x73\x1e\x56\x46\xbc\xbc\x4c\x6c\xe1\x55\x09\xa7\x20\x94\x4e\xfe\x9a\x18\x6f\x7c\x64\xff\x35\x31\x01\x39\xf3\x99\x56\x96\xb3\xfd\xb9\x07\xe7\x52\xda\x22\xa4\x2d\x13\xa7\x79\xd7\x36\x2b\x39\x8b\xcc\x8b\x7e\x5e\xd4\xdf\xe2\x87\x64\x21\x42\xde\x9c\x7f\x2a\xc5\x48\xad\x8a\x7e\xee\x1f\xfc\x98\x8f\xc4\x3a\x43\xc1\x6e\x87\xcd\xe9\x74\xd9\xdd\x6e\x9b\x83\x71\x9e\x2a\xa8\xde\xa3\x44\x55\xfe\xbd\x50\x2e\x8c\xc0\xdd\x46\xb5\x1e\xc4\xb8\xbd\x0e\x7b\x2b\x0b\x84\x9c\x26\x0a\xc5\x67\x2d\x5e\x6f\x0c\x83\x43\xe9\xd9\x9a\x1e\xf4\xd6\xdf\x92\xf7\xa1\x0b\x6f\xf5\xc2\x7a\x92\xf7\xd0\x62\xff\xb4\xf0\xbf\x24\x66\xe1\x06\x07\x5e\x57\xc7\x9e\xa1\xcb\x42\xe8\xe7\x01\x14\xb1\xa1\xa8\x28\x0b\x9f\xa0\xcf\xa2\xc1\xb8\x18\x0a\x22\x87\x85\x35\x22\x94\x56\x58\xad\x1c\x1f\xe2\x27\x9b\x95\x52\x12\x3f\x7e\xde\x56\x34\x8e\xf1\xb2\xa7\xb3\x8f\xab\x64\x2b\xb9\xa5\xe9\x9c\xe1\x7f\xea\xa5\x0c\x47\x6c\xd0\x61\x6f\x69\x3d\x37\xc0\x17\xcf\x2f\xc9\x44\x57\x7a\x51\xcf\xb1\x86\x22\xbe\x9a\x18\xbe\xd4\x83\x8f\xe7\x61\x1d\x6f\x23\x1d\x94\x7f\xad\x9d\x02\x17\xb6\x15\xc8\xac\xb3\x13\x74\x0a\x91\xf2\x68\x58\x5b\xc8\xa9\x77\x76\x01\x28\x87\x94\xd4\x9c\x9a\x48\x22\xfa\xd6\x92\xbe\x1d\x29\x17\x8b\x90\x15\x42\x6a\x04\x2b\x31\x8d\x3a\xc6\xd6\xa7\xe8\x90\x34\x10\x6d\x87\x94\x25\x45\x29\xd7\xbc\x5c\x6f\x10\x18\xb5\x94\xb1\xa6\xe5\xff\x5d\xaa\x33\xd3\xa6\xd3\x92\xb4\xa9\xb4\x8f\x9f\x3c\xc0\xf9\x69\x92\xcb\x43\x98\x4f\x3d\x20\x95\x7e\xf2\x95\xa1\x5c\x29\x30\x08\xce\x6f\x4c\x07\x5c\xab\xc4\x9c\xbc\xfc\x59\x28\x6a\x47\x0b\x24\xc6\x2f\x4f\x7d\x07\xbf\xc8\x9e\xc0\xf3\x95\xd3\x80\xcd\x70\x01\x6f\x2d\x41\x36\x1f\xd0\x37\xbd\xbd\xdf\xd0\x8f\x6f\x5d\x52\xe4\x93\x06\x67\xd2\xdd\xe9\x43\x6c\x9c\xe9\x86\x0f\x55\xaa\xad\x79\x12\x4f\xf7\xab\x68\xe1\x46\xde\x2c\xa4\x33\x90\xdf\x90\xe5\x73\xfd\x10\xc3\xca\xbb\xf6\x9c\xee\x34\x91\x5e\x64\x6f\xaf\x0a\x87\x6c\x60\xea\x60\xb4\xd5\xd7\x5e\xd4\x51\xc3\xf3\x0f\xf6\x9f\x75\x2c\xd0\x5a\xdb\x63\x94\x43\xf5\xa3\x1c\xfa\x4e\xe3\xa7\xa4\x7b\x37\x67\xf2\x71\xfa\x2e\x63\x3b\x42\x9b\xc9\x67\x33\x44\x68\x37\xf9\xec\x86\x08\x1d\x26\x9f\xc3\x10\xa1\xd3\xe4\x73\x1a\x22\x74\x99\x7c\x2e\x43\x84\x6e\x93\xcf\x6d\x88\xd0\x63\xf2\x79\x0c\x11\xb2\x8c\xc9\x47\xd2\x00\x42\x7a\xa1\xba\x11\x7b\x6e\x3f\x56\x88\x92\x23\x45\x17\x94\xe5\x75\x52\xaf\x6f\x4f\x2a\xf7\xf6\x48\x19\xb9\xf4\xc2\xdb\xb8\x59\x6c\x69\x7d\xf0\x46\xe6\xe3\x7d\x82\xdc\x6d\xfa\xc1\x1f\xe6\xa3\xd7\xc9\xbe\x3e\x9d\xe6\xf6\x1b\xb0\x55\x42\x02\xb0\x86\xab\xe6\x12\xd5\xdd\xeb\x8e\xaa\x71\x11\xa0\xf4\x8c\x83\x6e\x5b\x0d\x27\x8a\x6a\xa3\x01\xd4\x33\x40\x1a\x4e\x13\x55\xcf\x47\x05\xf5\xb3\x32\x96\xa8\x20\x9b\x7c\x8d\xa7\x6c\x1a\x8e\x23\xf1\xbe\x13\x33\xad\xed\xa0\x90\x13\x33\xe8\xe4\x88\x8c\x91\x91\x02\xf4\x18\x0d\xf9\x36\xf9\x1a\xcf\xd4\xe8\xb2\xd6\xdf\x6b\x9d\xfc\xa8\xcf\x4e\x4d\xf0\x2e\x2b\x39\x82\xa1\x9f\xc8\x00\x2d\xfb\x3a\xfe\x03\x50\xd0\xdc\x75\x6e\x26\x00\x00")
+
+func dataThreadDeletedHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataThreadDeletedHtml,
+		"data/thread-deleted.html",
+	)
+}
+
+func dataThreadDeletedHtml() (*asset, error) {
+	bytes, err := dataThreadDeletedHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/thread-deleted.html", size: 9838, mode: os.FileMode(420), modTime: time.Unix(1792314732, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _dataThreadHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x5a\x7d\x53\x1a\xd9\x9a\xff\x5b\xab\xf2\x1d\xce\x60\xed\xec\x3f\x01\xba\x1b\x10\x44\xe5\xee\xec\x38\xb3\x37\x55\x93\x19\x6b\xcc\xbd\x3b\x77\xb7\xb6\x52\x2d\xdd\x11\x26\xd0\xcd\xa5\xdb\xa8\x93\xda\x2a\x7c\x41\x41\x41\xd0\x18\x5f\x51\x34\x89\x9a\x6b\xa2\xa2\x31\x8a\x0d\xea\x77\x99\xf4\x39\xdd\xfc\xe5\x57\xd8\x3a\xdd\x80\xf8\x42\xe8\xa9\x4d\x5d\xca\x02\xfb\xf4\x73\x9e\xf3\x7b\x9e\xf3\xbc\x9d\x97\x8e\xaf\xba\x7e\xfa\xf6\xd1\xdf\xba\xbf\x03\x3e\x31\x18\x00\xdd\x7f\xf9\xf7\x1f\x1e\x7c\x0b\x4c\x66\xab\xf5\x3f\x6d\xdf\x5a\xad\x5d\x8f\xba\xc0\x2f\x7f\x7e\xf4\xf0\x07\x40\x5a\x08\xf0\x28\x4c\x73\x82\x5f\xf4\xf3\x1c\x1d\xb0\x5a\xbf\xfb\xd1\x04\x4c\x3e\x51\x0c\xb9\xad\xd6\x81\x81\x01\xcb\x80\xcd\xc2\x87\xfb\xac\x8f\x7e\xb6\x0e\x62\x5e\x24\xee\x5c\xfe\xd7\x2c\xd6\xf4\xb4\x30\x22\x63\xf2\xdc\x6b\xee\xd0\x46\x1c\x0c\x06\x38\xa1\xf3\x0e\x3e\x64\x5b\x5b\x9b\xde\x5d\x27\x66\x69\x06\xff\xf6\xd2\x02\x0b\x7c\x61\xf6\x89\xde\x47\x70\x5b\xad\xbd\xbd\x82\x45\xa0\xc3\x74\x2f\x4d\x0a\xa2\xc5\xcb\x07\xad\x54\xaf\x95\x0e\x7b\x7d\xfe\x67\x6c\xd8\x6a\x02\x56\xdc\x4f\xf4\x8b\x01\xd6\xd3\x43\xa2\x7c\x1e\xc5\x52\x30\x21\xc9\x52\x02\xc5\xa7\xf4\x47\x59\x7a\x83\x16\x5f\xa8\x47\x47\xc0\x0c\xf4\x16\x75\x4f\x82\xab\x2b\xc0\x0c\x7a\x44\xba\x8f\x25\x05\x11\x98\x01\xe8\xe6\x07\xd8\x30\xcb\x80\xde\x21\xd0\xe5\x17\xbc\xfd\xbf\x7d\x05\xbe\x29\x0f\xd3\x61\xd5\x07\xb8\xd7\xdc\x11\xf0\x73\x4f\x8d\x20\x14\x7d\x61\x96\x66\xcc\xa4\x8d\xb4\x39\x1c\x76\x33\x69\x26\x2d\x9a\xb4\x20\xcc\x06\x3a\x4d\x5e\x9a\xe3\x39\xbf\x97\x0e\x94\xf1\x07\x59\x91\x06\x1c\x1d\x64\x3b\x4d\x4f\xd9\xa1\x01\x3e\xcc\x08\x26\xe0\xe5\x39\x91\xe5\xc4\x4e\x53\x23\xb9\x6e\x33\x61\x58\xc1\x1b\xf6\x87\xf0\x94\xd4\xf0\x01\x0d\x15\x74\xbf\xa2\x90\xdb\x2c\xfb\x58\x8e\x0d\xd3\x22\x1f\xae\x61\x58\xd1\xd3\x2f\x36\x8b\xfd\x76\x0f\xba\x5f\xf4\xdd\x49\xfe\x88\xa5\x83\x80\xe6\x18\xf0\x2d\x1f\x14\x58\xee\x37\xf0\x97\x07\x5a\xdb\x6d\x16\x5e\x3e\x34\x14\xf6\xf7\xf9\xc4\x1a\x2e\x14\x41\x90\x66\x8a\x20\x9d\xd5\xee\x0f\x38\xaf\xa5\xdc\x57\x10\x87\x02\x2c\x10\x87\x42\x6c\xa7\x49\x64\x07\x45\xab\x57\x10\xb0\x85\x35\xf5\xf2\xcc\x10\x78\xfe\x84\xe7\x44\xf3\x13\x3a\xe8\x0f\x0c\xb9\xc1\x5f\xd9\x30\x43\x73\x74\xfb\xf7\x3f\xfd\xf8\xc8\xdc\xf3\xe0\xbf\xbe\x73\x03\x92\x0a\x0d\xb6\x3f\xfc\xe6\xe7\xff\x78\xf0\xa3\x1b\x10\xed\x5e\x3e\xc0\x87\xdd\xa0\x85\xd0\x3e\xed\xbd\xb4\xf7\x69\x5f\x98\xef\xe7\x18\x37\x68\x79\xa2\x7d\xda\xff\xf7\x5e\x73\x93\x3f\xd8\x07\x9e\xf7\xf2\x61\x86\x0d\xbb\x09\xad\x25\xe0\x07\xcf\x83\x74\xb8\xcf\xcf\x99\x45\x3e\xe4\x06\xae\xd0\xa0\xd6\x6e\x09\xd1\x7d\x2c\x78\x1e\xa2\x19\xc6\xcf\xf5\xb9\x81\x3d\x34\xd8\x0e\xf4\x9e\x3a\x21\x19\x1a\x04\x2d\xdf\x69\x1f\x20\xf0\x01\x3f\xa3\xf5\xd2\x35\x09\x9e\x5f\x01\x30\xeb\xd0\x34\xd2\xef\xbf\x6f\x07\x55\x8e\xad\x77\x72\x64\x18\x86\x61\xd9\x2b\x8e\x2d\x1c\xfd\xec\x3e\x68\x29\xeb\xf4\x3e\x68\x61\x39\xa6\x06\x96\xeb\x8a\x89\xce\x40\xeb\x58\x01\xd6\x0e\xbc\x01\x96\x0e\xbb\x41\x2f\x2f\xfa\xda\xc1\x80\x9f\x11\x7d\x6e\xd0\xe6\xf8\x97\x76\xa0\xcb\xec\x06\x74\xbf\xc8\x57\x9e\xca\x30\x88\xb2\x0a\x5a\xb0\xaf\xb3\xe1\xfb\xa0\xe5\x09\xcf\x8b\x6c\x18\x3c\xbf\x46\x47\x61\x3a\xa0\x11\x06\xf8\x3e\x3f\xf7\x84\x0f\x07\xc1\x73\x3c\x95\x66\x3a\xe0\xef\xe3\xdc\xc0\xcb\x72\x22\x1b\xc6\xbc\x3a\xac\xda\x74\xe3\x79\xb7\x56\x23\x08\x9e\xe7\x67\xd8\x4b\x3b\x4d\x2d\x36\xed\x63\x02\xd7\x1f\x31\x99\xce\x04\xf8\x99\x4e\x93\x8e\x47\x6b\x65\xfc\xcf\x3c\xf8\x0b\x68\x7c\x3b\x4d\x8c\x5f\x08\x05\xe8\x21\x37\xf0\x73\x01\x3f\xc7\x9a\x7b\x03\xbc\xf7\x69\xbb\xc9\xd3\xa1\x7b\x57\xad\x9d\xfd\x4a\x3f\xa3\xf5\x56\x93\xa7\x19\x00\x00\xfa\x78\xbe\x2f\xc0\x3e\xa6\x99\xc7\xde\x80\x9f\xe5\x44\xd0\x09\x4c\x5e\xda\x1c\xea\xef\x35\xdb\x09\x97\xcd\x4e\xd8\xed\x04\xe1\x74\xb6\x3a\x5b\x5d\xa6\xf6\x1b\x3d\x84\x00\xaf\xd1\xbb\x5a\xdb\x6c\x0e\x47\xab\x8b\x22\x6e\x91\x68\x5a\x07\x9d\xc0\x49\xb9\x6e\xbe\xf2\xb1\xd8\x63\x40\x27\x68\x23\xda\xb1\x8e\x34\x54\x9e\xe6\x8e\xaf\xcc\x66\xa0\xe6\x4e\xd0\x6e\x02\xbe\x39\x42\xab\x1b\xf0\xf4\x02\xce\x4c\x02\xb3\xd9\xd3\xfc\x79\x81\x9a\x85\xb0\xb7\xd3\x64\xb5\x62\xe3\xa5\x19\xca\xa2\x0f\x25\x0c\x71\x8c\xdf\x4b\xe3\x18\xa3\x05\x3d\xfd\xad\x55\xf0\xf1\x03\x8f\x69\x46\xb0\xfc\x2a\x98\x3c\x57\xc3\x77\x58\x35\xe5\xea\xdf\x3e\xca\x53\x09\x34\xff\x2a\x54\xa3\x2c\xe8\xb0\xfa\x28\x6d\x32\xf5\xd9\xd1\xa7\x02\x4f\x11\x47\x3f\xd3\x9c\xb8\x83\x2e\x87\x5e\x8b\xd5\xe4\xd1\x83\x78\x87\x95\xf6\x80\xaf\xc3\x02\xfd\xf7\x7e\xbe\x1d\x54\x09\x9e\xf8\x19\xb3\x5d\x8f\xb8\x9e\xda\x98\x7f\x9d\xbc\x51\x3c\xd4\xe1\xde\x6b\xd6\x4d\x43\x83\x52\x76\x19\x0d\x4e\x53\x53\x47\x08\x78\x03\xb4\x20\x54\x83\x9d\xde\xdc\xd4\xd4\x21\x88\x61\x9e\xeb\xf3\x3c\x64\x03\xa2\x9f\xa3\xb1\xa5\x6a\xcf\xfa\x6b\x98\x9a\x51\x37\xde\xca\xd2\x34\xa0\x08\xb2\xd5\xec\x34\x53\x24\xa0\x48\x37\x69\x6f\xea\xb0\x86\xca\x9c\x7d\xb6\x86\xf9\xac\xc3\xea\xb3\x69\xd4\x00\x65\xde\xc3\xfc\x3c\xca\x44\x60\x7a\x5a\x99\x3b\x00\xe5\x61\x41\xed\x10\x14\x20\x49\xb7\xc3\x01\x94\xe2\xbc\x7a\x3e\x03\x3a\x7a\xc3\xc0\xea\x69\x2e\xff\xc8\xa7\x53\x30\x9e\x54\x96\xc7\x60\x7e\x1e\xee\xa6\x61\x2a\x86\x0e\x37\x64\x69\xfc\xb2\x98\x80\xe3\x49\x58\x8c\xc8\xf9\x88\x9c\xdf\x41\xf3\x39\x65\x79\x4c\xef\x73\xef\xaa\x53\x69\xe4\xb5\x9c\xdf\xf9\x3d\xb2\xf5\x7b\x64\x4b\xf9\x50\x50\x0a\x59\x94\x4a\x2b\x6f\x24\xf5\xd5\x3b\xbd\x1f\x4c\xbd\x83\x27\x47\x25\xa9\xa0\x9c\x4f\x2b\xcb\x63\x4a\x3c\x26\xe7\x0b\xea\xfe\x11\x8a\xcd\xa0\xf8\x39\xcc\xa5\xe0\x76\x5a\x9d\x7e\x07\xd3\x49\x18\x3d\x86\x5b\x4b\x30\xb6\xae\x0d\x9e\xc5\x8f\x7b\x6b\x28\x36\x23\xe7\x93\x4a\x76\xb3\x34\xfc\x42\xce\x4b\x72\x21\x22\x9f\xc6\x2e\x8b\x09\xf9\x34\x7b\xd5\x5e\x98\x86\x2f\x76\x4a\x13\x09\x18\x5b\x57\x96\xc7\x74\x30\x28\x1e\x91\x0b\x9b\x3a\x4e\xf5\x62\x11\x65\xe2\xb2\xb4\x82\x0e\x37\xe0\x5e\x02\xc5\xd2\xba\x22\xab\x42\x5f\x16\x13\x78\xa0\xd3\x2c\x26\x98\x5c\x43\x1f\x5e\x62\xe9\xdf\x6c\xc3\xbd\x63\x94\x89\xab\x7b\x39\x78\xbe\xa0\x2c\x8f\xa9\x13\x3b\xf0\xe4\x00\x6b\x25\x35\x23\xe7\x23\xa5\xe1\x24\x4c\x6f\x57\x54\x52\xef\x17\xa6\x66\x60\x7e\x5e\xdd\x1e\x83\xb1\xa5\xcb\xe2\x72\xa5\x19\x1d\x6e\x54\xda\x12\x4a\xec\x00\xce\x4e\xe1\xbf\x4c\xee\x56\xf7\x68\x52\x3d\x7a\x0d\x5f\xe7\xd0\xe2\x74\x4d\x77\xdd\xa6\x61\x6a\x07\xa5\xd2\xf2\xf9\x0a\x96\xe7\xe3\x30\x3c\xfd\x00\x53\x39\xcc\x50\x5a\x57\xa4\x13\x75\x6b\x58\x47\xac\x6e\xbf\x86\xe3\x1f\xb0\xd6\x74\xfb\xd9\x3f\xc1\x7a\xbf\x18\x85\xfb\xa7\xea\xc1\x06\x8a\x4e\x97\x16\x0f\x4b\x3b\x0b\xba\x22\xe4\xc2\x62\x69\xf6\x40\x97\x59\xfd\x98\x43\x73\xfb\x72\x61\x11\xee\xad\xca\xa7\x53\xca\x41\xa1\x9e\x94\x4d\x46\x5c\x22\xc8\x87\x83\x2c\x27\x36\x74\x09\xcd\x5e\xed\xc4\x75\x97\xa8\x9a\x3c\x9c\x90\xba\x7b\xec\x30\xfa\x16\xbe\x1a\x85\xb9\xe1\xd2\xdc\x30\x9c\x5c\x57\xcf\xd3\x30\x37\x66\xc7\x46\x16\xd7\xec\x23\x9f\x84\x89\x68\x69\x64\x4f\x93\xe8\x04\xad\x17\xd5\xfd\x13\x65\x7b\x58\x1d\x9e\x34\x04\x95\x0d\x0c\x0e\x19\xc1\x69\x73\xdb\xdb\xea\xe0\xbc\xe5\x9a\x98\xe7\x95\x5f\xba\xcc\x2e\x2c\xa6\xcd\x75\xb7\x5b\xea\x92\x00\x5b\x57\x4f\xb9\x72\xad\x68\xfa\x6b\xae\x57\x08\xb5\x83\xf2\x4f\xe9\x1f\xef\xb1\xe1\x6f\x6e\xaa\x1f\x57\xd4\xb3\x2d\xe0\xbc\x2c\x26\xda\x08\x00\xa3\xa3\x77\xd3\xa3\xc5\x2c\xf6\x89\xe4\x06\xda\x9f\x03\x72\x3e\x5e\x9a\x48\xaa\x07\x3b\x30\x39\x7d\x59\x4c\x90\xb6\xcf\x75\x9c\xcf\xa9\xc7\x51\x45\xda\xae\x8e\x08\xa3\x63\xb0\x98\x05\x54\xa3\x11\x33\x11\xa5\x10\x83\xa7\x05\x34\xfa\x01\xa8\x93\x23\x70\x33\x05\xe3\xdb\x30\x23\x5d\x16\x63\xa5\xd5\x35\x35\xf7\x0a\x9e\xbe\xbc\x2c\xc6\x81\xeb\x1a\x93\xb2\xfc\x3f\x36\x90\x1f\x4e\x4d\xa8\x91\x28\x9a\x5f\x40\xc3\xc7\xa5\xf3\x25\xd0\x76\x0b\x0d\x8e\x02\xf9\x49\x65\xef\x40\xdd\x3f\x82\xd1\xb7\xb5\xb6\xf1\x29\x32\x02\x13\x0b\xb2\xf4\x1e\xa6\xf6\x4b\xab\xaf\x64\xe9\xcd\xa7\xc8\x88\x21\xf3\x60\x7e\xfd\xfb\x63\x82\x24\x1a\x5a\x88\x0d\x90\x76\x37\xe1\x32\x6a\x21\x65\xb6\xb5\x46\xe2\x04\xa4\xc3\x4d\xd9\xef\x36\x12\x78\x72\x08\x27\x24\x43\x88\x9f\xd2\x61\x9a\x22\x88\xc6\x90\xed\x80\x6c\x75\x13\xf5\x8c\x1a\x1d\x8c\xc8\xf9\x08\x4c\xe5\xd0\x7c\xce\xd6\xd5\xf3\xc3\x0f\x38\x50\xe6\xb7\x94\xc4\xb4\x2c\x49\xbf\xe0\x18\xb9\xbf\x8a\xe5\xd9\x3c\xbb\x19\x21\x42\xc2\x65\x71\xb9\x1a\xf2\xe1\xfc\x89\x2c\x8d\x1b\x42\xde\xf5\x43\x4f\xd7\xf7\x7f\x33\x86\x9b\x72\x18\x55\xb5\xce\xb5\x56\xd3\xa4\x1d\xfb\x23\x45\xd4\xf1\x47\xad\xf1\xcb\xaa\xda\x01\x88\x36\x37\x65\xaf\x03\xb9\x8c\xb0\xae\xa4\xd5\x0c\x31\x21\xe9\x53\xc2\xb1\x03\x36\x46\x08\x04\x40\x1b\x9a\xcf\x01\x94\xd9\x51\xd6\x8f\xd4\xed\xd7\x00\xe6\xb7\xd0\xc2\x26\x56\xc0\x74\x56\x7d\x1d\x85\xd1\xa8\x32\xf7\x11\x2e\xbd\x05\x28\x77\x84\x96\x8e\xe1\xeb\x0f\x40\xd9\x78\x0f\x13\x51\x3d\xbb\xd6\xa4\x79\xd2\x01\x4f\x8f\xe4\x53\x9c\xf9\x01\xcc\x4a\x98\x45\xe6\x2d\x4c\x8f\x2a\xc3\xd5\x3c\x55\xda\x90\xe4\xf3\x0b\x38\x21\x81\xa0\xcf\xd6\x07\x82\x3e\x3b\xfe\x02\x16\x8b\xa5\x9a\xe9\x32\x12\x5c\x7a\x5b\x7a\x95\x51\xe3\x87\x97\xc5\xac\x1e\x8d\x2f\x8b\x59\x75\xf4\x0c\x26\x5f\xc2\xfc\x56\xd0\x37\x28\xe7\x93\x7f\x28\x93\x88\xfd\x7c\x98\x1d\x30\xa0\x60\xb2\xcd\x6d\xa3\xea\x28\x58\x0f\x31\x30\xb6\x0a\x57\xd6\x82\x7d\xc2\x33\x94\x7c\x85\x0b\x9d\xfc\xbe\x12\x8f\x85\x04\xbb\x1e\x76\x00\x1f\x06\x68\x61\x53\x89\xc7\x6c\x8c\xa0\x37\x19\xcb\x75\x7e\x5f\xff\x10\xdb\x10\x61\x2b\x20\x9c\x6e\xa2\x9e\x09\xdc\xb2\x5a\x9d\x6b\xad\xd5\x62\x6b\xa0\xdc\x76\xf2\x73\x01\xe2\x53\x64\x44\xff\x33\x84\x1c\xae\x8e\xe0\x61\x87\x37\xe5\x7c\xc4\x00\x7e\x1c\xe0\xc8\x3a\xf8\xcb\x70\xeb\x8a\x5d\x35\xe1\x78\x12\x4d\xcf\xc2\xe4\xba\x7c\x96\x84\xe7\x51\x5d\x14\x5c\x94\x65\x22\xea\xc5\x0c\xca\xc4\xe1\xc4\xba\x9c\xdf\xe9\xee\xf9\xab\x3e\x03\xca\x6e\x1c\xc5\x5f\x94\x22\xcb\x70\x42\x42\xd3\xf1\xeb\x32\xa3\x83\x11\x75\x7f\x4b\x29\x18\x8b\x2e\x4c\xc8\xc7\xfa\x07\x49\x23\x82\x3a\x6f\x95\xe9\x55\x41\x2b\x81\xbb\x6e\x06\xa8\x71\xd6\xee\x1e\x7b\xa9\x30\x53\xda\x1d\xb1\xa1\xfc\xbe\x9c\xdf\xd5\x23\xa8\x6e\x86\x4a\xfc\x14\x46\xf3\x70\x36\x81\x26\x4f\x61\x7e\xf4\xb2\x98\xa0\x08\x02\x46\x47\x6b\xb3\xd6\x8d\xf2\xbd\x52\x05\xc3\xe4\x3c\x9a\x8e\xcb\xd2\xb8\x56\x20\x67\xd1\xc1\x48\xf7\x43\x5c\x7f\xcf\xe6\x4b\x07\xc5\xd2\x8b\x73\x34\xbd\xf9\x65\xa3\x97\x13\x57\x3f\xa4\xb3\x8e\x46\x2a\x02\xb7\xb6\xe0\x2a\x3b\x9d\x80\xbb\x5b\x28\x32\x2d\x9f\xe2\xd5\x05\x4c\x25\xa1\x34\x57\xa1\xf8\xb7\x72\xa0\xfb\x92\xc9\x40\xc3\x66\xaf\xe7\xf8\x0d\x93\x81\xd3\x4c\xb9\x70\x6c\x26\xda\xfe\x79\xc9\xc0\xa9\xe5\x5d\xdb\x1f\x4d\x06\x65\x49\x6b\x56\x1b\xb2\x34\x4e\xa1\xf7\x1b\xca\xbb\x25\x38\x1e\x95\x2f\x36\xb0\x1f\x1d\x6e\x28\x99\x29\x18\xcb\xc1\xf4\xc2\x65\x31\x7b\x43\x98\xec\x2e\x3a\x8e\xa1\xe1\x7d\xb8\x97\x80\xd1\xb7\xe8\x70\x03\xa5\xd2\xa5\xf1\x59\x59\x1a\xaf\xf6\xd3\x17\x48\x70\x65\x0d\xbe\x49\xca\x67\x78\x95\xd5\xfd\x50\x96\xc6\xbf\x74\xb9\xe4\x04\xa4\xab\x7e\x41\x5d\x76\xd5\x3b\xc3\x89\xe6\x9c\xd5\x92\xef\x0f\xf8\xc4\x8d\x78\xb9\xb2\x26\x9f\xad\x6b\xb2\x61\x97\x3b\x5b\x57\x32\x53\x4a\x66\xca\x58\xdc\x8c\xc6\x94\xec\x26\x5a\x58\x57\xb2\x9b\x0d\x25\xd5\x6a\x7f\xa2\xb5\xfe\x12\x47\xcf\x39\x68\x31\x8b\x3e\x9e\x38\x00\x69\x77\xd4\xae\x68\x40\xb5\x9c\x35\x84\x2c\xc0\x0b\x41\x23\x88\x5a\xeb\x2f\xba\x8c\x46\xf2\x6a\x88\xbe\x2c\x2e\xab\x67\x6f\xd5\x8b\x53\x3d\x05\x55\xcb\xfa\xa3\x57\x6a\xea\x10\x87\x84\x93\x4d\xf9\x2c\x03\xf7\xde\x00\x3d\x0a\x02\x8a\xbc\xaa\x68\xf6\xd6\xe0\xf8\x47\x38\xb9\x52\x9a\x48\xa1\xd7\xb3\x4a\x7a\x14\x8f\x46\x39\x89\xeb\x35\x65\xd5\x80\x6f\xf1\xc4\x25\x91\x34\xae\x1b\xfb\x97\xcc\xd6\x2e\x6c\x9f\x44\xbd\xb0\x82\xd5\x7c\x87\x86\xca\x8a\xad\xaf\x81\x5a\xb4\xd7\x25\x53\x2f\x16\x71\xb8\x9c\x79\x65\xd4\xd1\x8c\xce\xb4\xcb\x4d\xd5\x0b\xdc\x75\x67\xba\x2c\x7c\x75\x07\xa2\x06\xdb\xad\x04\x1c\x0a\xfe\xe1\xbc\x83\x96\x5e\xc0\xfd\x59\x23\xe0\xdb\xdc\x44\xbd\x32\xbf\xbb\xc7\x6e\x45\xb1\x45\x65\x73\xcd\x66\xd5\xed\xca\x5a\x75\x15\x2b\x5a\x58\x97\xf3\x85\xd2\xab\x45\x3d\xcb\x5a\x95\x95\x59\xb8\x9b\x46\xb1\xb4\x1a\x3f\xc4\x05\xc6\xe9\x34\x76\xde\x85\x97\xd5\xea\xd7\x49\xd4\x66\x5c\x5c\xc9\xbe\x87\x99\x9c\xbe\x52\x24\x5b\x89\x2f\x6b\x59\x14\xe9\xb6\xd5\x8b\xfe\xf5\x2d\x0b\x4f\x64\x15\xde\x4d\xbd\xdf\xaa\x05\xbb\x1f\x1a\x9b\x8a\xd5\x1c\x8c\x4e\xc1\xd4\x21\xdc\x1f\x47\xf3\xfb\x9f\x07\xef\x32\x6b\xdb\x35\x94\xe1\x64\x7b\x83\x3b\xb8\xce\x09\xaf\x6c\xee\x4e\xba\xb8\xae\x3b\xda\xed\x7e\x58\xda\x1d\x29\xbd\x38\xbe\x2c\x26\xd4\xad\x61\x98\xdf\x52\xf7\x36\xd0\xee\x26\x5c\x7a\xab\x46\x12\x72\x7e\xae\x3a\xdd\xca\xf2\x98\x56\x41\xc6\xd1\x58\x0a\xa6\x67\xb4\xbd\xbe\x2d\x7d\xf1\xd1\xfd\x10\x27\xb3\xf4\xb6\x21\x65\x84\x59\x86\xf7\x8a\x7c\x2f\x1b\x6e\xac\x07\x8a\xac\x5f\x74\xa0\xb9\x63\x3d\xa6\xcb\xf9\x79\xe5\x65\x02\xad\xcf\xa8\x17\x27\x70\xef\x9d\x1d\xc6\x56\x95\x78\x0c\xf4\xb2\x82\xa8\xbd\x4d\xaa\x5b\xc3\x00\x2f\xab\xf2\x39\x18\xcb\xc9\x85\xc5\x2f\x97\x62\x2b\xdb\x09\xf5\x32\xac\xba\xff\x12\xcd\x1d\x77\xf7\xd8\x75\xdf\x29\xed\xce\x61\xa4\xb1\x45\x75\x2f\xe7\x30\x66\x39\x1b\xa3\xea\xe4\x01\x3c\x5c\x91\xf3\x85\x46\x50\x5c\x80\x24\xdc\x54\x3d\x93\xbf\x6d\x36\x35\xac\xaf\x6c\x86\x24\x74\xb5\x13\xb6\x3a\x0b\xa0\x09\x49\xd9\x5c\x53\xb6\x17\xd1\xc7\x13\x98\xcd\x62\xbb\xf8\x50\x80\xb1\x85\xcb\x62\xe2\x53\x64\xb2\xf6\x95\xfd\x53\x64\x0a\xce\xde\x6c\x25\x29\xdb\x9f\xbb\x60\x3a\x56\x5a\x19\xff\x14\x99\xba\xb9\xa7\x51\x39\x07\xa6\x2c\x22\xcd\xf7\xd2\xbc\x76\x1a\xe2\x17\xd9\xa0\xc5\x27\x06\xff\x24\x84\xf0\x32\x18\xfc\xe6\x1b\xf8\x9a\x0e\x86\xda\xfd\x4c\xa7\xc3\xd6\xda\xea\xb4\xbb\x5c\x36\x07\xd1\x5a\x4d\x76\xd7\xf2\xa3\x31\x2d\xff\x63\x49\xce\x8f\xca\xf9\xd1\x46\x2a\x6e\x03\x84\xcb\xed\xa8\xb7\x68\x41\x73\xc7\x72\x7e\x87\xb3\x31\x42\x25\xdd\x6c\x0d\xa3\x83\x11\xad\xd6\xd3\x62\xe2\xe6\xd9\x65\x31\xa1\x2c\x9d\x69\x0b\xf7\x04\xdd\xa6\xef\x27\x5c\x57\xf0\xef\x91\xb9\xdf\x23\x73\x00\xad\x6e\xaa\x13\x3b\xe0\x21\xeb\xff\xad\x1f\x04\x6d\x80\xe3\x45\xf6\x3e\xf8\x86\x63\xc2\xbc\x9f\x01\x0e\x0b\x69\x48\xb0\x52\x7e\x43\xb9\x28\xc2\x37\xdb\x4a\x21\x0a\x5f\xbf\x6b\x2c\x1e\x45\xb8\xc9\x1b\x25\xcb\x23\xbf\x18\x60\xeb\x9b\xd1\x8d\x21\xae\x6d\x00\x51\xd8\x22\x1d\xf5\xf6\xda\x26\xa4\x67\x4a\x3c\x76\x3b\x7d\x63\x7d\x69\x7b\x06\x14\x69\x2c\x25\xa8\x91\xe1\x07\x5d\xf0\x62\x51\x96\xc6\x1b\x49\x48\x12\x9f\x75\x12\x98\xdf\x43\x99\x29\x98\x9a\xd2\xa7\x12\xa0\xb5\xe1\xd2\x52\x5a\x1d\x3b\x80\x13\x12\x05\x50\x6c\x5e\x8d\x44\x81\xbe\xd9\xab\xef\xc2\xc8\x92\x84\x16\xb3\x4a\x7a\xf4\x53\x64\x44\x8f\x46\x06\x93\x18\x37\x24\xf4\x73\x0d\xd1\x92\x78\xb1\x4b\xd5\xdb\x0d\xa8\x91\xfb\x66\x32\xbb\x92\xb4\xea\x16\xff\x7f\xd1\x6e\xcc\x9f\x46\x8b\x6b\xad\xc2\x09\x7c\xb3\x0c\x73\x33\x78\x45\x10\x9b\x81\xb1\xe5\x52\x64\x19\x46\x3f\x18\x2b\xb0\xbc\x03\x24\x65\x33\xa8\x88\x7a\xa9\x40\xdb\xb1\xdb\x41\x4b\x52\xe9\x7c\x09\x27\x81\xec\xf4\x2f\xa5\xf3\x25\xf9\x74\x4a\x79\xb7\x7e\x33\xc4\x98\xcd\x66\x33\x4c\xcd\x94\x22\xc3\xd8\xbf\x7e\xea\xee\xfe\x49\xff\xfa\xd9\x29\x04\xef\xd7\xf8\x97\xe6\x61\xda\x59\x65\x59\x80\x10\xdd\xc7\xea\xf0\x4b\x1b\x1f\xdd\xa0\xb9\x22\xc1\x7f\x93\xff\x53\x85\x0f\x9a\xab\xc7\xa6\xa2\xbf\x7a\x65\x45\x3b\x3c\xfd\x13\xee\xdf\x49\x99\x3c\x94\x76\x70\xda\x88\xd0\x66\xf2\xd8\x0c\x11\xda\x4d\x1e\xbb\x21\x42\x87\xc9\xe3\x30\x44\xd8\x6a\xf2\xb4\x1a\x22\x74\x9a\x3c\x4e\x43\x84\x2e\x93\xc7\x65\x88\xb0\xcd\xe4\x69\x33\x44\x48\x12\x26\x0f\x4e\xce\x98\xb4\xa9\x72\xb6\x7c\xc7\x19\x33\xcb\x69\x57\xa8\x9a\x50\x76\x13\x2f\xbc\xf7\x12\xe8\xe5\x11\x5e\x06\x66\xde\xbb\xaf\x1d\x82\x5b\xea\xdf\x32\x12\xe9\x70\x1f\x2b\x76\x9a\x1e\xf7\x06\x68\xee\xa9\xc9\x53\x99\xf7\xc6\x47\xca\x65\x42\x8c\xf2\x0a\x5c\xd5\x1e\xcb\x27\xf3\xda\xde\x4e\x05\xae\x7e\x8f\x43\xb7\xb2\x9a\x4b\x54\x95\x21\x3b\xe8\x9b\x68\x6a\xee\x4f\x95\x6f\x85\x31\xda\xcd\x20\x0b\xc7\x8a\x26\x4f\xed\xa5\xa2\x9a\x1b\x58\xb4\xe7\xca\x60\x2b\xa7\x3f\x5e\x3e\x34\x04\xae\xee\x03\x19\x19\xc9\xab\xdf\x19\xc2\xbf\x26\x4f\xed\x05\x22\x5d\xe0\xab\x2d\xab\x9a\xff\xae\xa6\xaa\x2a\x7e\x87\xb5\x97\x67\x86\xf4\x9b\x27\x62\x30\xe0\xb9\xd7\xfc\x7f\x01\x00\x00\xff\xff\x07\xfe\x7e\xf8\x6c\x27\x00\x00")
 
 func dataThreadHtmlBytes() ([]byte, error) {
@@ -172,6 +383,26 @@ func dataThreadHtml() (*asset, error) {
 	return a, nil
 }
 
+var _dataViewthreadHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\x7b\x73\x13\x47\xb6\xff\x5f\x9f\xa2\xd3\xa9\x5c\x43\x05\xa9\xe7\x61\x63\x30\xa3\xb9\x95\x60\x6e\x25\x75\xb1\x6f\xea\xe2\x54\xb8\x77\x6b\x4b\xd5\x9a\x69\x8d\x1a\xe6\x95\xe9\x96\x25\x39\x9b\x2a\x27\x0b\xac\x09\xe6\x91\x4a\xc2\x06\x70\xc2\x52\x1b\x12\x36\x61\x13\xd8\x3c\x56\x71\x0c\xf9\x32\x1a\x59\xfe\x8b\xaf\xb0\xd5\xf3\xd2\x48\x96\x08\x10\xd8\xad\xf5\x1f\x9e\x7e\x9c\x3e\x7d\xfa\x9c\xd3\xbf\x73\x4e\x4b\x7b\x6e\xfe\x7f\x0e\x2f\xfd\xdf\x6b\x47\x40\x9d\x3b\x36\x78\xed\xf5\x97\x8f\xbe\x7a\x18\xc0\x22\x42\x6f\xa8\x87\x11\x9a\x5f\x9a\x07\xc7\x5f\x59\x5a\x38\x0a\xe4\x92\x04\x96\x02\xec\x32\xca\xa9\xe7\x62\x1b\xa1\x23\x8b\x10\xc0\x3a\xe7\xfe\x1c\x42\xcd\x66\xb3\xd4\x54\x4b\x5e\x60\xa1\xa5\xff\x45\x2d\xc1\x4b\x16\x8b\x93\x66\x91\xe7\x56\x96\x4c\x6e\x42\xbd\xa0\x89\x19\xd0\x72\x6c\x97\x95\xc7\xb0\x91\x0f\x1e\x3c\x18\xaf\x8e\x68\x09\x36\xf5\x82\xe6\x10\x8e\x81\xa0\x2d\x92\x37\x1b\x74\xb9\x0c\x0f\x7b\x2e\x27\x2e\x2f\x2e\xb5\x7d\x02\x81\x11\xf7\xca\x90\x93\x16\x47\x62\xed\x21\x60\xd4\x71\xc0\x08\x2f\x37\x78\xad\x78\x00\x02\xa4\x17\x34\x4e\xb9\x4d\xf4\x63\x72\xaf\xd3\xe9\xad\x5d\x0c\xd7\x37\xbb\x9b\xeb\xbd\xb3\xe7\xe2\x6e\x77\xf3\xb3\xde\xc7\x1f\xf4\xbf\xfb\x0e\x14\x41\x3c\xd2\xff\x7a\x33\xfc\xe4\x1a\x28\x02\x70\x8c\x63\x8b\xc8\x8c\x8b\x36\x8b\xda\x88\xc9\x09\x55\xf8\xde\xad\xde\xd6\x57\x31\xad\x86\xe2\x2d\x12\x81\x5d\xec\x90\x32\xb4\x88\x4b\x02\xcc\xbd\x20\x27\xe7\x3c\x65\x46\x63\xe5\x39\x70\x5c\x2d\x4d\xc7\xb2\x55\x31\x23\xa0\x1e\x90\x5a\xac\x13\x36\x87\x50\xb5\xca\x4a\x0c\x07\xb8\x8a\x65\xc6\x4b\x86\xe7\x20\xa5\x8a\x62\x6a\x94\xe8\xa5\xea\x99\x6d\x40\xcd\x32\x74\x97\x2b\x35\x2f\x68\x38\x10\x18\x36\x66\xac\x0c\x7d\xab\xb2\x4c\x49\x93\xd7\x03\x82\x4d\x08\x3c\xf7\x24\x69\x9b\x5e\xd3\x2d\x43\x5a\xdb\x43\x96\x89\xcb\x4b\x27\x49\xfb\xb0\x67\x92\x72\x59\x99\xdd\x0b\x02\xc2\x1b\x81\x0b\x6a\xd8\x66\xe4\x90\xd0\xbc\x49\x97\x23\xce\x4d\x3f\xe3\xd9\xf4\xf3\x13\x06\xcf\x4d\x00\xc3\xce\xcf\xf9\xd6\x60\xd2\xb7\x18\x70\xaa\x0e\x30\x6c\x90\x92\x64\x33\x1c\xea\xc3\x03\x50\xd7\x18\x0f\x3c\xd7\xd2\x65\x0d\x25\x2d\x0d\x27\x8a\x89\x0f\x53\x94\x55\x59\x9d\x99\x99\x2e\x2a\x45\xb9\x24\x6c\x0d\x75\x45\x43\x78\x22\x99\x9a\x91\xa9\x11\x99\x8d\xab\xc4\xd6\x35\xea\xfa\x0d\x0e\x78\xdb\x27\xb1\xdb\xc0\xc4\x5c\x46\x83\x71\xcf\xf1\xb1\x45\x06\x27\x68\x41\xc0\xe8\x0a\x29\x43\x05\x82\xc8\xc2\x65\xd8\xbf\xff\x41\x78\xfa\xe6\xce\x8d\xef\xb7\xff\xf4\xce\x83\xad\xf5\xde\xfa\xd9\xf0\xda\xa7\xfd\x7b\x9f\x87\x3f\x7f\xb5\xb3\x7a\xbd\xff\xf7\x6f\xfb\xf7\x6e\x43\xb0\x8c\xed\x06\x29\x43\x59\x58\x4d\x63\x3e\x76\xd3\xe5\xe1\xe9\xbb\x40\x05\x3b\x37\xbe\x87\x3a\x40\x71\x4b\x43\x82\x40\xd7\x50\x22\xe0\x2f\x9d\x3a\x95\xce\x6d\x71\xa8\x77\x3b\xe7\xba\x9d\xd5\x88\x0b\xd6\x35\x64\xd2\xe5\xe4\x7f\x21\xfd\x64\xa6\xf1\x18\xb7\x29\xcb\xd9\xc7\x06\x55\x47\x58\x86\xe3\xaa\x4d\x80\x41\x6c\x9b\xf9\xd8\xa0\xae\x55\x86\x12\x8c\xfa\x3e\x36\xcd\xa4\x2f\xe8\x02\xf1\xcf\x1c\xac\x67\xc0\xe7\x2e\xf0\xab\xee\x88\x7d\xeb\x8e\x98\x10\x83\xe2\x64\xe9\x68\xcb\x92\xa1\xde\xbb\x7e\x73\x7b\xe3\xdc\x5c\x72\x66\x30\x4c\x40\x65\xa8\xcf\xec\x9f\x3d\x70\x30\x55\x49\x7e\xd6\xa7\x3e\x81\xfa\xef\xc6\x4d\x45\x9c\xc3\x6b\x9f\x86\x9f\x9d\x7f\x08\x67\x59\x51\xa7\x93\xd9\x4c\x39\x88\x9b\x23\x67\x32\x80\xcf\x1d\x71\x26\xb0\xdc\xe4\xf5\x18\xb7\xe4\x74\x9a\x33\xd1\x4f\x0d\x14\x5d\xbc\x92\x5f\xf7\xff\xd3\xf1\xcc\x72\xd4\x33\x29\xf3\x6d\xdc\xfe\x0f\xec\xf8\x87\x6a\xd4\x2c\x4f\x27\x2d\x9b\x93\xa0\x2c\x7c\x8e\x9a\xd1\x48\xdc\x2c\xcf\x1c\x80\xfa\x6f\x62\x00\xfa\xad\x30\x60\xa2\x30\x61\xae\xd8\xfa\x15\xd6\xa8\x9e\x20\x06\x87\xbf\x88\x5e\x83\x93\xd5\xe5\xec\x60\x28\x32\x19\x8a\x0c\x3c\xe2\x0a\x15\x55\x91\x24\x59\x92\x64\x08\x32\x17\x10\xfb\xfa\xd4\x1c\xcc\x64\x5a\xa9\x53\x17\x02\xd6\x70\x1c\x1c\xb4\x47\x69\x9e\xc0\x6f\x20\x08\xbc\xa6\x90\x57\xdc\xac\x9c\x60\x35\xbc\x8c\x39\x0e\xc6\x08\xc0\x40\x32\x37\xe2\x6a\x3e\x1d\x19\xc0\x0d\x5e\xa7\x70\x70\x8b\x84\x4b\x93\x62\x83\x9a\x45\x55\x99\x9e\xd9\x9f\x5c\x21\x8e\x03\x8b\xf0\x32\xac\x54\x6d\xec\x9e\xcc\xf6\x69\x35\x65\xa8\x2f\x10\x9b\x53\x17\xc7\xf6\xd8\x75\x97\x86\x70\x2b\x15\xe9\x17\x76\xcb\xa8\xb9\xb3\x6b\x6b\x5d\xa3\x8e\x05\x58\x60\x0c\xf0\x3f\xe6\x3a\x12\x02\x4c\xcc\x71\x32\x83\x24\x49\x42\x92\x8a\x94\x69\x34\xb3\xbf\x12\x8f\x55\x1c\x6a\x9a\x36\x29\x9d\xf0\xad\x08\x73\x26\xa1\xc1\x18\x87\x1f\xab\xd1\x04\x82\x0b\x93\xd0\x48\xce\xd0\x08\x64\x2e\xe5\x36\x9c\x81\xe5\x3c\xd7\xb0\xa9\x71\xb2\x0c\x19\xe1\x87\x3d\xbf\xbd\x87\xd7\x29\x2b\x09\x5e\xfb\xc0\x54\xd8\xb9\x1c\xfe\xf5\x52\xb8\x71\x27\xfc\x64\x35\xfc\xec\x7c\xb8\xf6\x43\x6f\xed\x52\xf8\xde\xf5\xa9\xbd\x87\x46\xe3\x51\xef\xe6\x56\xb7\xf3\x53\x62\x8c\x4c\xaa\xbc\xbc\x7c\x82\x0b\x14\x22\xcd\xe6\xc7\x0c\x17\x2c\x3b\x30\x92\x37\xa2\x31\x3c\x77\x20\x70\x64\x02\xc6\x31\xa7\x06\xa2\x0e\xb6\x08\x32\x3c\xc7\xf1\x5c\xe4\xb9\x36\x75\x49\xc5\x21\x4e\x95\x04\x25\x8b\xd6\x84\x82\x0b\x1a\x71\x32\x46\x5e\xe0\x7b\x8c\xe7\x98\xe9\xe1\xc5\xf7\xfb\x37\x6e\x75\x37\x2f\x00\x45\x92\xf7\x17\x67\x8b\x8a\x0c\x14\x79\x4e\x9e\xd6\x10\x71\xf4\xc2\x43\x80\x6d\x12\xbc\x0c\x82\x7a\x0c\x20\xd4\x2c\x27\xb6\x88\xfa\x22\x6e\x95\xe5\xa8\x29\x8e\xe6\x05\xd4\x2c\x47\x0e\x0f\x41\x40\xec\x32\x74\xbd\x9a\x67\xdb\x5e\x13\xea\xe1\xc5\x2f\xb7\x37\xce\xf5\xbf\xb9\xd9\xbd\xb7\xd1\x5f\x3d\x3d\xce\xcd\xd1\xa8\x9f\xfb\x02\x81\x34\xc6\xdb\x36\xc9\x45\x4e\x64\x30\x06\xf5\x92\x6f\x54\xdf\x72\x70\x60\x51\xb7\x18\x50\xab\xce\xe7\xa4\xb7\x45\x08\x6f\xdb\x64\x84\x47\x75\xc4\x4e\xbc\x52\x63\x2b\x8f\x1e\x80\x04\x8e\xe4\x3c\x97\x57\x6a\x30\x73\x3d\x87\x30\x86\x2d\x32\x00\x35\xbd\xa0\xd1\x94\xd2\x17\x56\x6d\x30\xa8\x83\xde\xc6\xed\xb0\x73\xb9\xb7\xb1\x1a\x5e\xba\xb0\xfd\xe1\x5d\x90\x5c\x75\x90\x37\x94\x02\x64\x79\x6e\x66\x06\x6c\x6f\x5d\xee\xdf\x7f\x1f\x68\x88\xea\x5a\x35\x88\x4c\x1e\x7f\xba\x3f\x9e\x0b\xcf\x9e\xdf\xbe\x7a\x2a\xf1\xe2\x8b\x6b\xbd\xbf\xdd\xe8\x6e\x9e\x79\xb0\xb5\x1e\x9e\x39\x1f\x6e\xad\x76\x3b\xab\xdd\xce\x97\xbd\xcb\x77\xb6\xaf\x9e\x4a\x96\x86\x17\xdf\x0f\x3b\x97\xfb\x5f\x9c\x0a\xd7\xae\x3c\xd8\xba\xaa\xe1\x47\x49\xf9\xb2\x2b\x27\x89\xbf\xdc\x95\x1b\x85\x10\x61\xcf\x9f\xaf\xec\xfc\x61\x5d\x18\x73\x58\x58\x8d\x5a\xae\x17\x90\xca\x09\x56\xf1\x7c\xd1\x75\xac\x48\x69\x98\x3a\x56\x45\x96\x94\x69\x08\xb0\xe8\xc7\xcd\x89\x77\xc0\xf5\x5c\x12\xbb\xfe\x8a\xe7\x39\x35\x6a\x93\x81\xec\xd4\xb1\x46\x64\x8f\x3c\x17\x09\x85\x4a\xb3\x48\x91\x91\x22\xcb\xd3\x92\x84\x31\x8e\xe1\xe9\x57\x2e\x4f\xac\x2a\x04\x81\xa0\x49\x4d\x5e\x2f\xc3\xfd\x92\x04\x01\xb6\x79\x19\x06\x0d\x9b\xb0\x78\x9f\x24\xe5\xca\x8d\x34\x13\x4a\x34\xe2\x88\xd4\x07\x9c\xfa\x95\x69\x10\xa9\x85\x53\x1f\x0e\x2b\xa9\xe2\x10\xb7\x01\x41\xe4\xd4\x91\xc3\x45\x95\xcd\x1c\xc0\x55\xe6\xd9\x0d\x4e\x0e\x81\x24\xf0\xcf\x01\xa1\x29\x28\xba\xb8\xc1\xbd\x9a\x67\x34\x04\xff\xa0\x41\x46\x7c\xbf\xc5\xa2\xd0\xe8\x67\x69\x6f\x26\x65\x86\x72\x40\x40\x4c\x4a\x2e\x32\x9c\x3d\x07\xa4\xd2\x0c\xf8\xef\x97\xf7\x81\x6e\xe7\x5c\xff\xde\xbd\xde\xed\x1b\xbd\x8f\xee\xcc\x01\x75\x6f\x84\x2a\x1a\xf2\x47\xae\x70\x41\x43\x23\xe6\x17\xf0\x1f\x65\x05\x83\xa4\x20\xa1\x4c\x43\xb0\x30\x38\x71\xf3\xe9\x41\x22\x82\xe1\xc0\x01\x75\x5d\x4d\x25\xf3\x19\xaf\x83\x16\x93\xe1\x70\x4a\x46\x0d\xcf\xad\x04\xd4\xb5\x04\xe2\xea\x09\xb6\xf5\xd6\x3e\xee\x5d\xfe\x63\xf8\xde\x35\xb0\xf3\xe3\x69\x0d\xd5\x55\xb1\xaf\x1d\xa9\x3a\xc0\x9c\xd8\x9e\xb5\x7b\x5b\x31\x21\x36\x36\xcd\x54\xfd\x31\xd6\xcc\x49\xa9\x46\x53\x0c\xa8\x08\xd2\x01\x83\x2c\xf2\x25\xe8\x92\xb1\xb3\x07\x39\x49\x3d\x1d\x16\x81\x3f\xf5\x25\x59\x91\xa0\x3e\x01\x85\x1d\xca\x8c\x18\x64\x0d\xe1\x01\x11\x2a\x07\x98\x53\xd7\x62\xe3\x61\x39\x82\xe1\xf4\x48\x89\x43\xc6\x39\x70\x78\xfa\xd6\xce\xef\x6f\xf5\xbf\x39\x15\xae\x9d\xc9\x87\xcb\xba\xd7\x7c\x83\xba\xa6\xd7\xdc\x33\x95\xe3\x3e\xb5\x0f\x64\x01\x74\x2f\xd4\x41\x78\xf1\xdd\x6e\xe7\x42\x77\x73\xb3\xf7\xd1\x9d\x31\xb9\xae\x92\x68\x3c\x02\x04\xc4\xeb\x13\x0f\x7b\x40\x82\x3a\xd0\x68\xce\x34\xbb\x99\xbd\x38\xe0\x46\x07\xdc\xf4\xc2\x33\xd1\xd1\xe3\x29\xe2\x21\x2a\x1d\xf6\xc7\x36\xd4\x77\xd3\xe4\x94\x34\x1c\x92\x23\x1d\x6e\x5f\x3a\xb3\xfd\xe1\xdd\x84\xa6\x90\x9c\x5b\xdc\x9d\x82\xc6\xa3\xfa\x3b\x21\x16\x2e\x55\x49\x9c\x2a\xf3\xe5\xcc\x0f\x2b\x69\x38\xe2\x66\x4e\x63\x75\xcf\x21\x99\xc2\x44\xd0\x23\x91\x2a\x1a\x42\x35\x82\xfe\xa9\x65\x89\x12\x92\x25\x24\xc9\x69\x96\xc8\x1c\x6c\xdb\xf9\x24\x11\x3c\xb9\x48\xc4\x6e\xb5\x93\xec\x61\x24\xa9\x8c\xd4\x07\x5e\x04\xf2\xee\x19\x81\x63\xe1\xcd\x7b\xdd\xcd\xcd\x6e\x67\x75\xfb\xc3\xeb\xe1\x8f\xdf\x86\x5f\x9f\x4d\xe8\x12\xe5\x8e\xd3\xa2\x22\x49\xca\xe3\x68\x31\xa2\x7f\x8a\x5a\x54\x24\x24\x29\xbf\x4e\x8b\xe3\x45\x3a\x89\x03\xac\x48\x92\xf4\x44\x9a\x1c\x29\xf3\x84\x57\xe6\xeb\x3d\x64\x8a\x35\xc8\xb4\x07\xc0\x9d\x7c\x72\x91\x88\x51\xcb\xcd\x42\x9b\x83\x5b\xc5\x3a\x89\xb2\x38\x59\x92\xfc\xd6\x21\x07\xb7\x5e\x89\xfa\xaf\x1e\x49\x46\xa0\x1e\xd7\x9c\xbd\x8d\xb3\x3b\x7f\xbe\xb0\x73\xe5\x2f\x0f\xb6\xd6\xbb\x3f\x7d\xdc\xbb\x7d\x7f\x67\x63\xb5\x7f\xe7\x56\xef\xf4\x85\x6c\xb3\x34\xda\x14\x26\x86\x9b\x7c\x29\xaa\x4c\x2c\x45\x95\x47\x28\x45\x95\x67\x59\x8a\x2a\x4f\xb7\x14\x15\xae\x30\x3e\x97\x4b\xd7\x8a\x80\x34\xea\x1b\x43\x16\x4c\x3f\x4f\x5a\xcf\x0d\x63\x76\x40\x4c\x1a\x10\x83\x47\xf7\xc7\xf2\xb8\x57\xae\x51\xd7\x14\xc6\x89\x46\xfc\x87\x60\xb6\x32\xbe\x04\x54\x9e\x5e\x09\xa8\x11\x47\xbc\xf4\x89\x14\x87\x35\x7c\xfd\x79\x0d\x89\x4f\xa2\x94\xec\x60\x8f\x56\x12\x4e\xae\xda\x94\x7c\xd5\x36\xf4\x66\x97\x56\x06\xd3\x40\xde\x3f\x27\x1d\x9c\x53\x55\xa8\x8f\x8c\x65\x91\x84\x38\x39\xdb\xe4\x3e\x43\x16\x31\x46\x9f\x3f\xff\x89\xa5\x91\x32\xb2\xd3\x9b\x0d\x4f\x24\x58\x5a\xd5\xf6\x8c\x93\x51\x47\xd7\x6a\x9e\xcb\xb3\x87\x4f\xfd\x09\x5d\x26\x17\xd5\x77\xf9\xd0\x6e\x18\x8c\xf7\x34\x3c\xdb\x0b\xca\xf0\xf9\x83\xd1\x5f\xf6\x14\x03\x1e\x52\x4e\x8b\x75\xc9\x73\x47\xdc\x7c\x92\x22\x0d\xe5\x4f\x1f\xdb\x2b\x66\xd3\xbb\xfb\x6e\xb7\xb3\x1a\x5e\xbc\xd3\xbb\x7c\x47\x9d\x3f\x76\xf4\xa8\x58\xdf\xf9\x7c\x7b\x5d\xa4\x5e\xc7\xb7\xaf\x9e\xea\x7f\xf3\x89\x28\x27\x6f\xde\x1b\x84\x96\xa1\xfa\x89\x39\xd4\x26\x6d\x54\xc3\x06\x51\x24\x79\x16\x49\xb3\x72\xc9\x77\x2d\x08\xc4\x04\x25\xe2\xde\xc8\xaa\x34\x0b\x41\xd5\x0b\x4c\x12\x08\x6b\xc6\x75\x4c\x14\xa0\x93\x1a\xee\xb1\xb3\x76\x65\x7c\xd6\x3e\xf2\x79\x3c\x74\x56\x27\xa2\xb3\xfa\x08\xe8\xac\x3e\x4b\x74\x56\x9f\x2e\x3a\x8b\x84\xe3\x11\xd0\x39\x97\xff\x4c\x52\xef\xbf\x16\x99\xd5\xf1\xc8\xac\x3e\x5d\x64\x56\x9f\x39\x32\xab\x50\xdf\x0d\x00\x11\xf0\x2a\x33\xff\x06\x88\xab\x42\xbd\xb0\x7d\xff\xc2\xf6\xd9\x35\xa0\xce\x1f\x4b\x7e\x3d\xec\x75\x4e\x87\xe7\x3f\xea\x7f\xf1\xce\xce\x95\x53\xdd\x9f\x7e\x48\xe0\x66\xdc\x4d\xcf\x4b\x8d\x39\xb7\xe1\xee\x47\x1d\xd3\xce\xe4\x48\x29\x4c\xae\x17\x26\x40\x92\x78\x7e\x11\x8f\x78\x68\x85\xfa\xf1\x9b\x4e\x0e\x7b\x12\x3e\xcb\x4e\x0e\x86\x84\x5e\x05\xbb\x28\x9b\xf4\xd3\xad\x30\xe7\xae\x33\xf9\xe7\x10\xcc\x39\x36\xea\x0e\x71\x63\x3f\x15\x8f\x4c\x0b\x4b\x2f\xb5\x17\x8f\x37\xe5\xff\x3f\xb1\x58\x5f\x3c\xf1\x5f\xf5\x45\xb7\xd9\x5a\x5c\x3a\xd2\x5c\x58\xb1\xd4\xc5\xf9\x57\xa7\x6b\xf3\x2f\x1f\x58\x58\x5a\x68\x2d\xac\xbc\x2e\x2f\xbe\xf4\x82\x3a\xff\x82\x3a\x2f\x7c\xd5\xf1\x1a\x8c\x78\xcb\x42\x42\x56\xf7\x9a\x0b\xc4\x6d\xec\x79\x6b\xca\xe0\x81\x4d\xcd\xa9\xb9\xa8\x2c\xa6\xe6\xbe\x29\xdf\x63\x53\x73\x53\xb2\x32\xf5\xf6\xde\xf4\xf9\xc6\x94\x25\x65\x66\x77\xb0\x51\x4d\x56\x11\x3f\x85\x95\x56\xa8\x9f\x38\xab\x2f\x8e\xa6\xcb\x52\x49\x99\x1e\xf3\xc2\x32\x9b\xbe\xad\xe4\xf2\xe9\xd1\x97\x95\x21\x0f\x9c\x80\xcb\xea\x53\xc4\x65\x71\x80\x80\xf8\x76\x3b\xe3\xe9\xdb\x50\x1f\x22\xa9\xb8\xa4\x99\xcd\x0e\x1e\x90\x2b\x91\x6b\x65\x39\xff\xf0\xd3\x55\x1a\xff\x46\x64\x1a\xfe\xa4\x85\x46\x9d\x3b\xb6\x5e\xf8\xc7\x00\x24\xa3\xed\x1a\x53\x20\x00\x00")
+
+func dataViewthreadHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataViewthreadHtml,
+		"data/viewthread.html",
+	)
+}
+
+func dataViewthreadHtml() (*asset, error) {
+	bytes, err := dataViewthreadHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/viewthread.html", size: 8275, mode: os.FileMode(420), modTime: time.Unix(1792310950, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -224,11 +455,22 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"data/api-forumdisplay.json": dataApiForumdisplayJson,
+	"data/api-forumindex.json": dataApiForumindexJson,
+	"data/api-viewthread.json": dataApiViewthreadJson,
 	"data/bindata.go": dataBindataGo,
 	"data/forum.html": dataForumHtml,
+	"data/forumdisplay.html": dataForumdisplayHtml,
+	"data/forumindex.html": dataForumindexHtml,
 	"data/index.html": dataIndexHtml,
+	"data/login.html": dataLoginHtml,
+	"data/loginform-seccode.html": dataLoginformSeccodeHtml,
+	"data/loginform.html": dataLoginformHtml,
+	"data/nopermission.html": dataNopermissionHtml,
 	"data/single.html": dataSingleHtml,
+	"data/thread-deleted.html": dataThreadDeletedHtml,
 	"data/thread.html": dataThreadHtml,
+	"data/viewthread.html": dataViewthreadHtml,
 }
 
 // AssetDir returns the file names below a certain
@@ -272,11 +514,22 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"data": &bintree{nil, map[string]*bintree{
+		"api-forumdisplay.json": &bintree{dataApiForumdisplayJson, map[string]*bintree{}},
+		"api-forumindex.json": &bintree{dataApiForumindexJson, map[string]*bintree{}},
+		"api-viewthread.json": &bintree{dataApiViewthreadJson, map[string]*bintree{}},
 		"bindata.go": &bintree{dataBindataGo, map[string]*bintree{}},
 		"forum.html": &bintree{dataForumHtml, map[string]*bintree{}},
+		"forumdisplay.html": &bintree{dataForumdisplayHtml, map[string]*bintree{}},
+		"forumindex.html": &bintree{dataForumindexHtml, map[string]*bintree{}},
 		"index.html": &bintree{dataIndexHtml, map[string]*bintree{}},
+		"login.html": &bintree{dataLoginHtml, map[string]*bintree{}},
+		"loginform-seccode.html": &bintree{dataLoginformSeccodeHtml, map[string]*bintree{}},
+		"loginform.html": &bintree{dataLoginformHtml, map[string]*bintree{}},
+		"nopermission.html": &bintree{dataNopermissionHtml, map[string]*bintree{}},
 		"single.html": &bintree{dataSingleHtml, map[string]*bintree{}},
+		"thread-deleted.html": &bintree{dataThreadDeletedHtml, map[string]*bintree{}},
 		"thread.html": &bintree{dataThreadHtml, map[string]*bintree{}},
+		"viewthread.html": &bintree{dataViewthreadHtml, map[string]*bintree{}},
 	}},
 }}
 
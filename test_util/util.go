@@ -3,35 +3,123 @@ package test_util
 import (
 	"github.com/smy20011/s1go/test_util/data"
 	"io"
-	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 )
 
-const SinglePostThread = 111111
+const (
+	SinglePostThread = 111111
+	// RestrictedThread is only visible to higher user groups.
+	RestrictedThread = 222222
+	// LoginRequiredThread is only visible to logged in users.
+	LoginRequiredThread = 333333
+	// DeletedPostThread is the first page of thread.html after its 5th post
+	// was deleted and a new reply was posted.
+	DeletedPostThread = 444444
+	// MissingThread is answered with 404 Not Found.
+	MissingThread = 555555
+	// MockPassword is the password of every user of MockS1Website.
+	MockPassword = "secret"
+	// MockQuestionUser has set security question 1 with answer MockAnswer.
+	MockQuestionUser = "question"
+	MockAnswer       = "42"
+	// MockCaptcha is the text in the captcha image MockCaptchaImage.
+	MockCaptcha      = "ABCD"
+	MockCaptchaImage = "captcha image"
+	mockFormhash     = "a1b2c3d4"
+	mockAuth         = "B7Y9_2132_auth=mockauth"
+)
 
+// MockS1Website serves the fixtures in data like the site would.
 type MockS1Website struct {
+	// Logins counts the successful logins.
+	Logins int
+	// Captcha makes the login form ask for a captcha.
+	Captcha bool
 }
 
-func (m *MockS1Website) RoundTrip(req *http.Request) (resp *http.Response, err error) {
-	resp = &http.Response{
-		Request: req,
-	}
-
+// ServeHTTP serves the fixtures under any path prefix, e.g. /2b/.
+func (m *MockS1Website) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	url := req.URL.Path
-	if strings.Contains(url, "111111") {
-		resp.Body = createResponseBody("data/single.html")
+	if strings.HasSuffix(url, "member.php") && req.URL.Query().Get("mod") == "logging" {
+		if len(req.URL.Query().Get("loginsubmit")) == 0 {
+			if m.Captcha {
+				writeFixture(w, "data/loginform-seccode.html")
+			} else {
+				writeFixture(w, "data/loginform.html")
+			}
+		} else if message := m.checkLogin(req); len(message) > 0 {
+			io.WriteString(w, "<root><![CDATA["+message+"]]></root>")
+		} else {
+			m.Logins++
+			w.Header().Set("Set-Cookie", mockAuth+"; path=/")
+			io.WriteString(w, "<root><![CDATA[欢迎您回来]]></root>")
+		}
+	} else if strings.HasSuffix(url, "misc.php") && req.URL.Query().Get("mod") == "seccode" {
+		io.WriteString(w, MockCaptchaImage)
+	} else if strings.HasSuffix(url, "api/mobile/index.php") {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		writeFixture(w, "data/api-"+req.URL.Query().Get("module")+".json")
+	} else if strings.Contains(url, "222222") || req.URL.Query().Get("tid") == "222222" {
+		writeFixture(w, "data/nopermission.html")
+	} else if strings.Contains(url, "333333") || req.URL.Query().Get("tid") == "333333" {
+		if cookie, err := req.Cookie("B7Y9_2132_auth"); err == nil && cookie.String() == mockAuth {
+			writeFixture(w, "data/thread.html")
+		} else {
+			writeFixture(w, "data/login.html")
+		}
+	} else if strings.Contains(url, "555555") {
+		http.NotFound(w, req)
+	} else if strings.Contains(url, "444444") {
+		writeFixture(w, "data/thread-deleted.html")
+	} else if strings.HasSuffix(url, "forum.php") {
+		switch req.URL.Query().Get("mod") {
+		case "forumdisplay":
+			writeFixture(w, "data/forumdisplay.html")
+		case "viewthread":
+			writeFixture(w, "data/viewthread.html")
+		default:
+			writeFixture(w, "data/forumindex.html")
+		}
+	} else if strings.Contains(url, "111111") {
+		writeFixture(w, "data/single.html")
 	} else if strings.Contains(url, "fid") {
-		resp.Body = createResponseBody("data/forum.html")
+		writeFixture(w, "data/forum.html")
 	} else if strings.Contains(url, "tid") {
-		resp.Body = createResponseBody("data/thread.html")
+		writeFixture(w, "data/thread.html")
 	} else {
-		resp.Body = createResponseBody("data/index.html")
+		writeFixture(w, "data/index.html")
+	}
+}
+
+// checkLogin returns why the login form posted in req is rejected, or "".
+func (m *MockS1Website) checkLogin(req *http.Request) string {
+	req.ParseForm()
+	form := req.PostForm
+	switch {
+	case form.Get("formhash") != mockFormhash:
+		return "您当前的访问请求当中含有非法字符，已经被系统拒绝"
+	case m.Captcha && (form.Get("seccodehash") != "cSA" || form.Get("seccodeverify") != MockCaptcha):
+		return "抱歉，验证码填写错误"
+	case form.Get("password") != MockPassword:
+		return "登录失败，您还可以尝试 4 次"
+	case form.Get("username") == MockQuestionUser && (form.Get("questionid") != "1" || form.Get("answer") != MockAnswer):
+		return "请选择安全提问以及填写正确的答案"
 	}
-	return
+	return ""
+}
+
+// NewFixtureServer starts a local HTTPS server serving the test fixtures.
+func NewFixtureServer() *httptest.Server {
+	return httptest.NewTLSServer(&MockS1Website{})
+}
+
+// BaseURL returns the root of the forum served by server.
+func BaseURL(server *httptest.Server) string {
+	return server.URL + "/2b/"
 }
 
-func createResponseBody(file string) io.ReadCloser {
-	reader := strings.NewReader(string(data.MustAsset(file)))
-	return ioutil.NopCloser(reader)
+func writeFixture(w io.Writer, file string) {
+	w.Write(data.MustAsset(file))
 }